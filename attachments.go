@@ -0,0 +1,199 @@
+package lrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultAttachmentChunkSize is the upload chunk size used when
+// AttachmentOptions.ChunkSize is not set
+const DefaultAttachmentChunkSize = 64 << 10
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrAttachmentChecksum is returned when the storage received contents
+	// other than the ones that were read
+	ErrAttachmentChecksum = errors.New("attachment checksum mismatch")
+)
+
+type AttachmentOptions struct {
+	ChunkSize int
+	// VerifyOnComment makes CreateComment, ReplyToComment check that every
+	// attachment exists before the comment is written
+	VerifyOnComment bool
+	// Concurrency bounds the metadata lookups of a verification, 8 if not set
+	Concurrency int
+}
+
+func (o *AttachmentOptions) withDefaults() AttachmentOptions {
+	var res AttachmentOptions
+	if o != nil {
+		res = *o
+	}
+	if res.ChunkSize <= 0 {
+		res.ChunkSize = DefaultAttachmentChunkSize
+	}
+	if res.Concurrency <= 0 {
+		res.Concurrency = 8
+	}
+	return res
+}
+
+// attachmentOptions fills in the defaults for clients that were not
+// created with New
+func (c *Client) attachmentOptions() AttachmentOptions {
+	return c.attachments.withDefaults()
+}
+
+// MissingAttachmentsError lists the attachments of a comment that do not
+// exist in the storage
+type MissingAttachmentsError struct {
+	Ids []string
+}
+
+func (e *MissingAttachmentsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrAttachmentNotFound, strings.Join(e.Ids, ", "))
+}
+
+func (e *MissingAttachmentsError) Is(target error) bool {
+	return target == ErrAttachmentNotFound
+}
+
+// UploadAttachment streams r to the storage and returns the new attachment
+// id. The contents are checksummed while they are read, so r is consumed
+// only once
+func (c *Client) UploadAttachment(ctx context.Context, uploaderId, contentType string, r io.Reader) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.Storage.Client.UploadAttachment(ctx)
+	if err != nil {
+		return "", err
+	}
+	err = stream.Send(&storage.UploadAttachmentRequest{
+		Payload: &storage.UploadAttachmentRequest_Info{Info: &storage.AttachmentInfo{
+			UploaderId:  uploaderId,
+			ContentType: contentType,
+		}},
+	})
+	if err != nil {
+		return "", uploadError(stream, cancel, err)
+	}
+	hash := sha256.New()
+	buf := make([]byte, c.attachmentOptions().ChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			// the chunk is copied as Send may keep the message after it returns
+			chunk := append([]byte(nil), buf[:n]...)
+			err = stream.Send(&storage.UploadAttachmentRequest{
+				Payload: &storage.UploadAttachmentRequest_Chunk{Chunk: chunk},
+			})
+			if err != nil {
+				return "", uploadError(stream, cancel, err)
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			abortUpload(stream, cancel)
+			return "", rerr
+		}
+	}
+	err = stream.Send(&storage.UploadAttachmentRequest{
+		Payload: &storage.UploadAttachmentRequest_Sha256{Sha256: hex.EncodeToString(hash.Sum(nil))},
+	})
+	if err != nil {
+		return "", uploadError(stream, cancel, err)
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", attachmentError(err)
+	}
+	return res.Id, nil
+}
+
+// abortUpload cancels an upload and waits for the stream to end, so that
+// the interceptors record the call before UploadAttachment returns
+func abortUpload(stream storage.StorageService_UploadAttachmentClient, cancel context.CancelFunc) {
+	cancel()
+	stream.CloseAndRecv()
+}
+
+// uploadError returns the status the server ended the stream with when a
+// Send fails with io.EOF, and aborts the upload on other errors
+func uploadError(stream storage.StorageService_UploadAttachmentClient, cancel context.CancelFunc, err error) error {
+	if err != io.EOF {
+		abortUpload(stream, cancel)
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	if err == nil {
+		return io.ErrUnexpectedEOF
+	}
+	return attachmentError(err)
+}
+
+func attachmentError(err error) error {
+	switch status.Code(err) {
+	case codes.DataLoss:
+		return fmt.Errorf("%w: %s", ErrAttachmentChecksum, status.Convert(err).Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", ErrAttachmentNotFound, status.Convert(err).Message())
+	}
+	return err
+}
+
+func (c *Client) GetAttachmentMeta(ctx context.Context, id string) (*AttachmentMeta, error) {
+	res, err := c.Storage.Client.GetAttachmentMeta(ctx, &storage.GetAttachmentMetaRequest{Id: id})
+	if err != nil {
+		return nil, attachmentError(err)
+	}
+	v := res.Meta
+	return &AttachmentMeta{
+		Id:          v.Id,
+		UploaderId:  v.UploaderId,
+		ContentType: v.ContentType,
+		Size:        v.Size,
+		Sha256:      v.Sha256,
+		UploadedAt:  time.Unix(v.Timestamp, 0),
+	}, nil
+}
+
+// VerifyAttachments checks that every id refers to an uploaded attachment,
+// returning *MissingAttachmentsError naming the ones that do not
+func (c *Client) VerifyAttachments(ctx context.Context, ids []string) error {
+	missing := make([]bool, len(ids))
+	err := forEachConcurrent(ctx, len(ids), c.attachmentOptions().Concurrency, func(ctx context.Context, i int) error {
+		_, err := c.GetAttachmentMeta(ctx, ids[i])
+		if errors.Is(err, ErrAttachmentNotFound) {
+			missing[i] = true
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	var res []string
+	for i, m := range missing {
+		if m {
+			res = append(res, ids[i])
+		}
+	}
+	if len(res) > 0 {
+		return &MissingAttachmentsError{Ids: res}
+	}
+	return nil
+}
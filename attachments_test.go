@@ -0,0 +1,64 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	f := newFakeStorage()
+	c := f.client()
+	c.attachments.ChunkSize = 4
+	data := []byte("0123456789")
+	id, err := c.UploadAttachment(context.Background(), "user", "text/plain", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{4, 4, 2}}; !reflect.DeepEqual(f.uploadChunks, want) {
+		t.Errorf("expected chunks %v, got %v", want, f.uploadChunks)
+	}
+	meta, err := c.GetAttachmentMeta(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(data)
+	if meta.Size != 10 || meta.ContentType != "text/plain" || meta.UploaderId != "user" || meta.Sha256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected meta %+v", meta)
+	}
+
+	_, err = c.GetAttachmentMeta(context.Background(), "missing")
+	if !errors.Is(err, ErrAttachmentNotFound) {
+		t.Errorf("expected ErrAttachmentNotFound, got %v", err)
+	}
+}
+
+func TestCreateCommentVerifiesAttachments(t *testing.T) {
+	f := newFakeStorage()
+	c := f.client()
+	c.attachments.VerifyOnComment = true
+	id, err := c.UploadAttachment(context.Background(), "user", "image/png", bytes.NewReader([]byte{1, 2, 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.CreateComment(context.Background(), "landmark", "user", "Nice", []string{id, "gone"}, 5)
+	var missing *MissingAttachmentsError
+	if !errors.As(err, &missing) || !errors.Is(err, ErrAttachmentNotFound) {
+		t.Fatalf("expected MissingAttachmentsError, got %v", err)
+	}
+	if !reflect.DeepEqual(missing.Ids, []string{"gone"}) {
+		t.Errorf("expected [gone] missing, got %v", missing.Ids)
+	}
+	if f.calls["CreateComment"] != 0 {
+		t.Error("comment was written despite missing attachments")
+	}
+
+	if _, err := c.CreateComment(context.Background(), "landmark", "user", "Nice", []string{id}, 5); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,336 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	// BreakerHalfOpen lets a few probe calls through to find out whether
+	// the service recovered
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(s))
+}
+
+// BreakerOptions configures the circuit breaker of a service. Only calls
+// that fail with Unavailable, DeadlineExceeded, ResourceExhausted, Internal
+// or Unknown count as failures and application errors such as NotFound as
+// successes. Calls that were canceled or throttled by RateLimits never
+// reached the service and are not counted at all
+type BreakerOptions struct {
+	// FailureRatio of the calls in Window opens the breaker, 0.5 if not set
+	FailureRatio float64
+	// MinCalls is how many calls Window needs before FailureRatio is
+	// checked, 10 if not set
+	MinCalls int
+	// Window is how far back calls are counted, 10s if not set
+	Window time.Duration
+	// OpenTimeout is how long the breaker stays open before probing, 5s if
+	// not set
+	OpenTimeout time.Duration
+	// Probes is how many calls are let through half-open. The breaker
+	// closes once all of them succeed and opens again on any failure.
+	// 1 if not set
+	Probes int
+	// PerMethod gives every method its own breaker instead of sharing one
+	// per service
+	PerMethod bool
+	// OnStateChange is called with the breaker's name, the service or
+	// service/method, whenever its state changes
+	OnStateChange func(name string, from, to BreakerState)
+}
+
+func (o BreakerOptions) withDefaults() BreakerOptions {
+	if o.FailureRatio <= 0 {
+		o.FailureRatio = 0.5
+	}
+	if o.MinCalls <= 0 {
+		o.MinCalls = 10
+	}
+	if o.Window <= 0 {
+		o.Window = 10 * time.Second
+	}
+	if o.OpenTimeout <= 0 {
+		o.OpenTimeout = 5 * time.Second
+	}
+	if o.Probes <= 0 {
+		o.Probes = 1
+	}
+	return o
+}
+
+// BreakerMetrics can be implemented by Metrics to receive breaker state
+// changes
+type BreakerMetrics interface {
+	BreakerStateChanged(name string, from, to BreakerState)
+}
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned without calling the service while its
+// breaker is open. It matches ErrCircuitOpen and has status Unavailable
+type CircuitOpenError struct {
+	Name string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return e.Name + ": " + ErrCircuitOpen.Error()
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+func (e *CircuitOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+// breakerOutcome reports whether the result of a call says anything about
+// the health of the service and whether it is a failure
+func breakerOutcome(err error) (counted, failed bool) {
+	if errors.Is(err, ErrThrottled) {
+		return false, false
+	}
+	switch status.Code(err) {
+	case codes.Canceled:
+		return false, false
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true, true
+	}
+	return true, false
+}
+
+type breakerChange struct {
+	from, to BreakerState
+}
+
+// breakerBuckets is how many parts the window is counted in, calls leave
+// the window one bucket at a time
+const breakerBuckets = 10
+
+type breakerBucket struct {
+	start    time.Time
+	calls    int
+	failures int
+}
+
+type breaker struct {
+	name     string
+	opts     BreakerOptions
+	notify   func(name string, from, to BreakerState)
+	now      func() time.Time
+	mu       sync.Mutex
+	state    BreakerState
+	openedAt time.Time
+	buckets  [breakerBuckets]breakerBucket
+	// generation changes with the state so that calls admitted before a
+	// change are not counted after it
+	generation int
+	probes     int
+	probesOk   int
+	// changes are reported once the lock is released, so that callbacks
+	// can call into the client
+	changes []breakerChange
+}
+
+// allow admits a call and returns the generation to report its result for
+func (b *breaker) allow() (int, error) {
+	b.mu.Lock()
+	defer b.notifyChanges()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.opts.OpenTimeout {
+			return 0, &CircuitOpenError{Name: b.name}
+		}
+		b.setState(BreakerHalfOpen)
+		fallthrough
+	case BreakerHalfOpen:
+		if b.probes >= b.opts.Probes {
+			return 0, &CircuitOpenError{Name: b.name}
+		}
+		b.probes++
+	}
+	return b.generation, nil
+}
+
+func (b *breaker) done(generation int, err error) {
+	counted, failed := breakerOutcome(err)
+	b.mu.Lock()
+	defer b.notifyChanges()
+	defer b.mu.Unlock()
+	if generation != b.generation {
+		return
+	}
+	if !counted {
+		// give the probe slot to another call
+		if b.state == BreakerHalfOpen {
+			b.probes--
+		}
+		return
+	}
+	switch b.state {
+	case BreakerHalfOpen:
+		if failed {
+			b.open()
+			return
+		}
+		b.probesOk++
+		if b.probesOk >= b.opts.Probes {
+			b.setState(BreakerClosed)
+		}
+	case BreakerClosed:
+		calls, failures := b.record(failed)
+		if calls >= b.opts.MinCalls && float64(failures) >= b.opts.FailureRatio*float64(calls) {
+			b.open()
+		}
+	}
+}
+
+// record counts a call in the current bucket and returns the totals of
+// the window
+func (b *breaker) record(failed bool) (int, int) {
+	now := b.now()
+	size := max(b.opts.Window/breakerBuckets, 1)
+	start := now.Truncate(size)
+	bucket := &b.buckets[(start.UnixNano()/int64(size))%breakerBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = breakerBucket{start: start}
+	}
+	bucket.calls++
+	if failed {
+		bucket.failures++
+	}
+	var calls, failures int
+	for _, v := range b.buckets {
+		if now.Sub(v.start) < b.opts.Window {
+			calls += v.calls
+			failures += v.failures
+		}
+	}
+	return calls, failures
+}
+
+func (b *breaker) open() {
+	b.openedAt = b.now()
+	b.setState(BreakerOpen)
+}
+
+func (b *breaker) setState(state BreakerState) {
+	from := b.state
+	b.state = state
+	b.generation++
+	b.probes, b.probesOk = 0, 0
+	b.buckets = [breakerBuckets]breakerBucket{}
+	if from != state {
+		b.changes = append(b.changes, breakerChange{from, state})
+	}
+}
+
+// notifyChanges reports the state changes made under the lock, it must be
+// called without holding it
+func (b *breaker) notifyChanges() {
+	b.mu.Lock()
+	changes := b.changes
+	b.changes = nil
+	b.mu.Unlock()
+	if b.notify == nil {
+		return
+	}
+	for _, c := range changes {
+		b.notify(b.name, c.from, c.to)
+	}
+}
+
+// breakers keeps the breakers of one connection
+type breakers struct {
+	opts   BreakerOptions
+	notify func(name string, from, to BreakerState)
+	mu     sync.Mutex
+	byName map[string]*breaker
+}
+
+func newBreakers(opts BreakerOptions, m Metrics) *breakers {
+	b := &breakers{opts: opts.withDefaults(), byName: map[string]*breaker{}}
+	bm, _ := m.(BreakerMetrics)
+	b.notify = func(name string, from, to BreakerState) {
+		if bm != nil {
+			bm.BreakerStateChanged(name, from, to)
+		}
+		if b.opts.OnStateChange != nil {
+			b.opts.OnStateChange(name, from, to)
+		}
+	}
+	return b
+}
+
+func (b *breakers) get(method string) *breaker {
+	service, name := splitMethod(method)
+	if b.opts.PerMethod {
+		service += "/" + name
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	br, ok := b.byName[service]
+	if !ok {
+		br = &breaker{name: service, opts: b.opts, notify: b.notify, now: time.Now}
+		b.byName[service] = br
+	}
+	return br
+}
+
+func (b *breakers) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(b.unary),
+		grpc.WithChainStreamInterceptor(b.stream),
+	}
+}
+
+func (b *breakers) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	br := b.get(method)
+	generation, err := br.allow()
+	if err != nil {
+		return err
+	}
+	err = invoker(ctx, method, req, reply, cc, opts...)
+	br.done(generation, err)
+	return err
+}
+
+func (b *breakers) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	br := b.get(method)
+	generation, err := br.allow()
+	if err != nil {
+		return nil, err
+	}
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		br.done(generation, err)
+		return nil, err
+	}
+	return newFinishedStream(ctx, s, desc, func(err error) {
+		br.done(generation, err)
+	}), nil
+}
@@ -0,0 +1,162 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreaker(t *testing.T) {
+	var transitions []string
+	b := newBreakers(BreakerOptions{
+		FailureRatio: 0.5,
+		MinCalls:     4,
+		Window:       time.Minute,
+		OpenTimeout:  time.Second,
+		OnStateChange: func(name string, from, to BreakerState) {
+			transitions = append(transitions, name+" "+from.String()+">"+to.String())
+		},
+	}, nil)
+	now := time.Unix(1000, 0)
+	const method = "/feed.LandmarkFeed/GetFeed"
+	b.get(method).now = func() time.Time { return now }
+
+	var invoked int
+	var result error
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return result
+	}
+	call := func(err error) error {
+		result = err
+		return b.unary(context.Background(), method, nil, nil, nil, invoker)
+	}
+
+	unavailable := status.Error(codes.Unavailable, "down")
+	// application errors are not failures
+	call(nil)
+	call(status.Error(codes.NotFound, "no such user"))
+	call(unavailable)
+	if len(transitions) != 0 {
+		t.Fatalf("opened below MinCalls: %v", transitions)
+	}
+	call(unavailable)
+	err := call(nil)
+	if !errors.Is(err, ErrCircuitOpen) || status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if invoked != 4 {
+		t.Errorf("expected the open breaker to skip the call, got %d calls", invoked)
+	}
+
+	// a failed probe opens it again, a successful one closes it
+	now = now.Add(time.Second)
+	call(unavailable)
+	if err := call(nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the failed probe to reopen, got %v", err)
+	}
+	now = now.Add(time.Second)
+	if err := call(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := call(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"feed.LandmarkFeed closed>open",
+		"feed.LandmarkFeed open>half-open",
+		"feed.LandmarkFeed half-open>open",
+		"feed.LandmarkFeed open>half-open",
+		"feed.LandmarkFeed half-open>closed",
+	}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("expected %v, got %v", want, transitions)
+	}
+}
+
+func TestBreakerPerMethod(t *testing.T) {
+	b := newBreakers(BreakerOptions{PerMethod: true}, nil)
+	if b.get("/storage.StorageService/GetComments") == b.get("/storage.StorageService/GetLandmark") {
+		t.Error("methods share a breaker")
+	}
+	if b.get("/storage.StorageService/GetComments").name != "storage.StorageService/GetComments" {
+		t.Error("unexpected breaker name")
+	}
+}
+
+func TestBreakerIgnoresUnsentCalls(t *testing.T) {
+	b := newBreakers(BreakerOptions{MinCalls: 2, OpenTimeout: time.Second}, nil)
+	now := time.Unix(1000, 0)
+	const method = "/feed.LandmarkFeed/GetFeed"
+	br := b.get(method)
+	br.now = func() time.Time { return now }
+	call := func(err error) error {
+		return b.unary(context.Background(), method, nil, nil, nil,
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return err
+			})
+	}
+	unavailable := status.Error(codes.Unavailable, "down")
+	throttled := &ThrottleError{Method: method, Err: context.DeadlineExceeded}
+
+	// canceled and throttled calls do not dilute the failures
+	call(unavailable)
+	call(status.Error(codes.Canceled, "canceled"))
+	call(throttled)
+	if br.state != BreakerClosed {
+		t.Fatalf("opened below MinCalls, state %s", br.state)
+	}
+	call(unavailable)
+	if br.state != BreakerOpen {
+		t.Fatalf("expected the breaker to open, state %s", br.state)
+	}
+
+	// neither closes the breaker when probing, and the probe slot is freed
+	now = now.Add(time.Second)
+	if err := call(throttled); errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("expected a probe to be let through")
+	}
+	if err := call(status.Error(codes.Canceled, "canceled")); errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("expected the throttled probe to free its slot")
+	}
+	if br.state != BreakerHalfOpen {
+		t.Fatalf("expected the breaker to stay half-open, state %s", br.state)
+	}
+	call(nil)
+	if br.state != BreakerClosed {
+		t.Errorf("expected a successful probe to close the breaker, state %s", br.state)
+	}
+}
+
+func TestBreakerCallbackReentry(t *testing.T) {
+	var b *breakers
+	const method = "/feed.LandmarkFeed/GetFeed"
+	b = newBreakers(BreakerOptions{MinCalls: 1, Window: 5 * time.Nanosecond,
+		OnStateChange: func(name string, from, to BreakerState) {
+			// callbacks may use the breaker they are called from
+			b.get(method).allow()
+		}}, nil)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.unary(context.Background(), method, nil, nil, nil,
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return status.Error(codes.Unavailable, "down")
+			})
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("state change callback deadlocked")
+	}
+	if b.get(method).state != BreakerOpen {
+		t.Errorf("expected the breaker to open, state %s", b.get(method).state)
+	}
+}
@@ -0,0 +1,194 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// chainServer is a storage server the client built by New talks to over
+// a real connection, so that calls go through every interceptor
+type chainServer struct {
+	storage.UnimplementedStorageServiceServer
+
+	mu          sync.Mutex
+	calls       int
+	md          metadata.MD
+	getLandmark func(ctx context.Context) (*storage.GetLandmarkResponse, error)
+}
+
+func (s *chainServer) GetLandmark(ctx context.Context, in *storage.GetLandmarkRequest) (*storage.GetLandmarkResponse, error) {
+	s.mu.Lock()
+	s.calls++
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	s.mu.Unlock()
+	return s.getLandmark(ctx)
+}
+
+func (s *chainServer) AddUser(ctx context.Context, in *storage.AddUserRequest) (*storage.AddUserResponse, error) {
+	return &storage.AddUserResponse{}, nil
+}
+
+func (s *chainServer) UploadAttachment(stream storage.StorageService_UploadAttachmentServer) error {
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&storage.UploadAttachmentResponse{Id: "attachment"})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// startChainServer serves srv on a local port and returns its address
+func startChainServer(t *testing.T, srv storage.StorageServiceServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	storage.RegisterStorageServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func TestClientChain(t *testing.T) {
+	srv := &chainServer{getLandmark: func(ctx context.Context) (*storage.GetLandmarkResponse, error) {
+		<-ctx.Done()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}}
+	var logs bytes.Buffer
+	m := &recordingMetrics{}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := New(ctx, Settings{
+		StorageOpts: &StorageOptions{
+			Address:  startChainServer(t, srv),
+			Timeouts: Timeouts{Default: 50 * time.Millisecond},
+			Breaker:  &BreakerOptions{MinCalls: 2, OpenTimeout: time.Hour},
+		},
+		Logger:  slog.New(slog.NewJSONHandler(&logs, nil)),
+		LogOpts: &LogOptions{SuccessLevel: slog.LevelInfo, FailureLevel: slog.LevelWarn},
+		Metrics: m,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// the deadline fires inside the breaker, which opens after two timeouts
+	reqCtx := WithRequestID(context.Background(), "req-1")
+	for i := 0; i < 2; i++ {
+		_, err := c.GetLandmark(reqCtx, "landmark", "user")
+		if !errors.Is(err, ErrTimeout) || !strings.Contains(err.Error(), "default budget") {
+			t.Fatalf("expected the default budget to fire, got %v", err)
+		}
+		if id, _ := RequestIDFromError(err); id != "req-1" {
+			t.Errorf("expected req-1 in the error, got %q", id)
+		}
+	}
+	_, err = c.GetLandmark(reqCtx, "landmark", "user")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the breaker to open, got %v", err)
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.calls != 2 {
+		t.Errorf("expected the open breaker to keep the call local, got %d calls", srv.calls)
+	}
+	if got := srv.md.Get(RequestIDHeader); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("expected the server to get req-1, got %v", got)
+	}
+
+	// logging and metrics sit outside the breaker and see every call
+	var codesLogged []string
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry struct {
+			Code      string
+			RequestID string `json:"request_id"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatal(err)
+		}
+		if entry.RequestID != "req-1" {
+			t.Errorf("expected req-1 to be logged, got %q", entry.RequestID)
+		}
+		codesLogged = append(codesLogged, entry.Code)
+	}
+	if want := "DeadlineExceeded DeadlineExceeded Unavailable"; strings.Join(codesLogged, " ") != want {
+		t.Errorf("expected logged codes %s, got %v", want, codesLogged)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.codes) != 3 || m.codes[2] != codes.Unavailable {
+		t.Errorf("expected 3 calls in the metrics, got %v", m.codes)
+	}
+	if len(m.states) == 0 || m.states[0] != connectivity.Ready {
+		t.Errorf("expected the initial state to be reported as ready, got %v", m.states)
+	}
+}
+
+// failingReader returns data once and then fails
+type failingReader struct {
+	read bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.read {
+		return 0, errors.New("disk on fire")
+	}
+	r.read = true
+	return copy(p, "some bytes"), nil
+}
+
+func TestAbandonedStreamReleasesLimits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	m := &recordingMetrics{}
+	c, err := New(ctx, Settings{
+		StorageOpts: &StorageOptions{
+			Address: startChainServer(t, &chainServer{}),
+			Limits:  RateLimits{Writes: Limit{MaxInFlight: 1}},
+		},
+		AttachmentOpts: &AttachmentOptions{ChunkSize: 4},
+		Metrics:        m,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if _, err := c.UploadAttachment(ctx, "user", "text/plain", &failingReader{}); err == nil {
+		t.Fatal("expected the reader error")
+	}
+	m.mu.Lock()
+	if len(m.finished) != 1 || m.codes[0] != codes.Canceled {
+		t.Errorf("expected the abandoned upload to be recorded as canceled, got %v", m.codes)
+	}
+	m.mu.Unlock()
+	callCtx, callCancel := context.WithTimeout(ctx, time.Second)
+	defer callCancel()
+	if err := c.AddUser(callCtx, "user"); err != nil {
+		t.Fatalf("expected the abandoned upload to free its slot, got %v", err)
+	}
+	if _, err := c.UploadAttachment(callCtx, "user", "text/plain", strings.NewReader("contents")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -2,6 +2,9 @@ package lrpc
 
 import (
 	"context"
+	"log/slog"
+	"slices"
+
 	feed "github.com/emalak/lrpc/rpc/feed"
 	storage "github.com/emalak/lrpc/rpc/storage"
 	"google.golang.org/grpc"
@@ -9,21 +12,79 @@ import (
 )
 
 type Settings struct {
-	FeedOpts    *FeedOptions
-	StorageOpts *StorageOptions
+	FeedOpts       *FeedOptions
+	StorageOpts    *StorageOptions
+	TagGraphOpts   *TagGraphOptions
+	ModerationOpts *ModerationOptions
+	AttachmentOpts *AttachmentOptions
+	// Logger, if set, logs every call made on both connections
+	Logger  *slog.Logger
+	LogOpts *LogOptions
+	// TelemetryOpts, if set, instruments both connections with
+	// OpenTelemetry
+	TelemetryOpts *TelemetryOptions
+	// Metrics, if set, receives call and connection state measurements
+	Metrics Metrics
 }
 
 type FeedOptions struct {
-	Address string
+	Address  string
+	Timeouts Timeouts
+	// Breaker, if set, fails calls fast while the service is failing
+	Breaker *BreakerOptions
+	Limits  RateLimits
 }
 
 type StorageOptions struct {
-	Address string
+	Address  string
+	Timeouts Timeouts
+	Breaker  *BreakerOptions
+	Limits   RateLimits
 }
 
 type Client struct {
 	Feed    *Feed
 	Storage *Storage
+
+	tagGraph    TagGraphOptions
+	moderation  *ModerationOptions
+	attachments AttachmentOptions
+}
+
+// dialOptions returns the options both connections are dialed with.
+// Interceptors run in the order they are added here, followed by the ones
+// specific to a connection
+func dialOptions(s Settings) ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	opts = append(opts, requestMetadata{}.dialOptions()...)
+	if s.TelemetryOpts != nil {
+		t, err := newTelemetry(s.TelemetryOpts)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, t.dialOptions()...)
+	}
+	if s.Metrics != nil {
+		opts = append(opts, metricsInterceptor{m: s.Metrics}.dialOptions()...)
+	}
+	if s.Logger != nil {
+		opts = append(opts, newCallLogger(s.Logger, s.LogOpts).dialOptions()...)
+	}
+	return opts, nil
+}
+
+// serviceDialOptions returns the interceptors specific to one connection
+func serviceDialOptions(s Settings, breaker *BreakerOptions, timeouts Timeouts, limits RateLimits) []grpc.DialOption {
+	var opts []grpc.DialOption
+	if breaker != nil {
+		opts = append(opts, newBreakers(*breaker, s.Metrics).dialOptions()...)
+	}
+	opts = append(opts, deadlines{timeouts}.dialOptions()...)
+	// limits come last so that waiting for them counts against the deadline
+	return append(opts, newLimits(limits, s.Metrics).dialOptions()...)
 }
 
 type Feed struct {
@@ -31,16 +92,18 @@ type Feed struct {
 	Client feed.LandmarkFeedClient
 }
 
-func newFeed(ctx context.Context, s Settings) (*Feed, error) {
+func newFeed(ctx context.Context, s Settings, opts []grpc.DialOption) (*Feed, error) {
 	conn, err := grpc.DialContext(
 		ctx,
 		s.FeedOpts.Address,
-		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		slices.Concat(opts, serviceDialOptions(s, s.FeedOpts.Breaker, s.FeedOpts.Timeouts, s.FeedOpts.Limits))...,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if s.Metrics != nil {
+		go watchConnState(conn, feed.LandmarkFeed_ServiceDesc.ServiceName, s.Metrics)
+	}
 	client := feed.NewLandmarkFeedClient(conn)
 	f := Feed{
 		conn:   conn,
@@ -58,16 +121,18 @@ type Storage struct {
 	Client storage.StorageServiceClient
 }
 
-func newStorage(ctx context.Context, s Settings) (*Storage, error) {
+func newStorage(ctx context.Context, s Settings, opts []grpc.DialOption) (*Storage, error) {
 	conn, err := grpc.DialContext(
 		ctx,
 		s.StorageOpts.Address,
-		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		slices.Concat(opts, serviceDialOptions(s, s.StorageOpts.Breaker, s.StorageOpts.Timeouts, s.StorageOpts.Limits))...,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if s.Metrics != nil {
+		go watchConnState(conn, storage.StorageService_ServiceDesc.ServiceName, s.Metrics)
+	}
 	client := storage.NewStorageServiceClient(conn)
 	st := Storage{
 		conn:   conn,
@@ -81,16 +146,24 @@ func (s *Storage) Close() error {
 }
 
 func New(ctx context.Context, s Settings) (*Client, error) {
-	client := Client{}
+	client := Client{
+		tagGraph:    s.TagGraphOpts.withDefaults(),
+		moderation:  s.ModerationOpts,
+		attachments: s.AttachmentOpts.withDefaults(),
+	}
+	opts, err := dialOptions(s)
+	if err != nil {
+		return nil, err
+	}
 	if s.StorageOpts != nil {
-		f, err := newStorage(ctx, s)
+		f, err := newStorage(ctx, s, opts)
 		if err != nil {
 			return nil, err
 		}
 		client.Storage = f
 	}
 	if s.FeedOpts != nil {
-		f, err := newFeed(ctx, s)
+		f, err := newFeed(ctx, s, opts)
 		if err != nil {
 			return nil, err
 		}
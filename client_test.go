@@ -260,8 +260,10 @@ func TestViewLandmark(t *testing.T) {
 
 func TestRecommendLandmarks(t *testing.T) {
 	const (
-		userId = "d00ba81c-4fd9-4e01-a383-97e25f926020" // Assuming a valid user ID
-		amount = 5                                      // Example amount of landmarks to recommend
+		userId    = "d00ba81c-4fd9-4e01-a383-97e25f926020" // Assuming a valid user ID
+		amount    = 5                                      // Example amount of landmarks to recommend
+		latitude  = 55.751244
+		longitude = 37.618423
 	)
 	ctx := context.Background()
 	client, err := New(ctx, Settings{
@@ -273,7 +275,7 @@ func TestRecommendLandmarks(t *testing.T) {
 	}
 
 	// Test with correct data
-	landmarks, err := client.RecommendLandmarks(ctx, userId, amount)
+	landmarks, err := client.RecommendLandmarks(ctx, userId, latitude, longitude, amount)
 	if err != nil {
 		t.Errorf("Unexpected error for RecommendLandmarks with valid data: %v", err)
 	}
@@ -283,7 +285,7 @@ func TestRecommendLandmarks(t *testing.T) {
 	fmt.Printf("Recommended landmarks: %v\n", landmarks)
 
 	// Test with invalid userId
-	_, err = client.RecommendLandmarks(ctx, "invalidId", amount)
+	_, err = client.RecommendLandmarks(ctx, "invalidId", latitude, longitude, amount)
 	if err == nil {
 		t.Error("Expected error for RecommendLandmarks with invalid userId, got nil")
 	} else {
@@ -399,42 +401,82 @@ func TestCreateComment(t *testing.T) {
 	invalidAttachments := []string{"invalidUUID", "anotherInvalidUUID"}
 
 	// Test on correct data
-	err = client.CreateComment(ctx, parentId, authorId, text, validAttachments, 3)
+	_, err = client.CreateComment(ctx, parentId, authorId, text, validAttachments, 3)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Test on invalid parentId
-	err = client.CreateComment(ctx, "invalidParentId", authorId, text, validAttachments, 3)
+	_, err = client.CreateComment(ctx, "invalidParentId", authorId, text, validAttachments, 3)
 	if err == nil {
 		t.Error("Expected error for invalid parentId, got nil")
 	}
 
 	// Test on invalid authorId
-	err = client.CreateComment(ctx, parentId, "invalidAuthorId", text, validAttachments, 3)
+	_, err = client.CreateComment(ctx, parentId, "invalidAuthorId", text, validAttachments, 3)
 	if err == nil {
 		t.Error("Expected error for invalid authorId, got nil")
 	}
 
 	// Test on invalid attachments
-	err = client.CreateComment(ctx, parentId, authorId, text, invalidAttachments, 3)
+	_, err = client.CreateComment(ctx, parentId, authorId, text, invalidAttachments, 3)
 	if err == nil {
 		t.Error("Expected error for invalid attachments, got nil")
 	}
 
 	// Test on non-existent parentId
-	err = client.CreateComment(ctx, gofakeit.UUID(), authorId, text, validAttachments, 3)
+	_, err = client.CreateComment(ctx, gofakeit.UUID(), authorId, text, validAttachments, 3)
 	if err == nil {
 		t.Error("Expected error for non-existent parentId, got nil")
 	}
 
 	// Test on non-existent authorId
-	err = client.CreateComment(ctx, parentId, gofakeit.UUID(), text, validAttachments, 3)
+	_, err = client.CreateComment(ctx, parentId, gofakeit.UUID(), text, validAttachments, 3)
 	if err == nil {
 		t.Error("Expected error for non-existent authorId, got nil")
 	}
 }
 
+func TestReplyToComment(t *testing.T) {
+	// Set up context and client
+	ctx := context.Background()
+	client, err := New(ctx, Settings{
+		FeedOpts:    nil,
+		StorageOpts: &StorageOptions{Address: "localhost:8080"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		parentId = "712d6060-284b-400b-805d-cc118cd41c5d"
+		authorId = "30de160d-e48c-4beb-94c3-b5c6eb8075e6"
+	)
+	review, err := client.CreateComment(ctx, parentId, authorId, gofakeit.HipsterSentence(10), nil, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.Id == "" || review.Timestamp == 0 {
+		t.Fatalf("Expected the created comment's id and timestamp, got %+v", review)
+	}
+
+	// Test on correct data
+	reply, err := client.ReplyToComment(ctx, review.Id, authorId, gofakeit.HipsterSentence(10), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.ReplyId != review.Id {
+		t.Errorf("Expected reply to %s, got %s", review.Id, reply.ReplyId)
+	}
+	fmt.Println(mustJSONString(reply))
+
+	// Test on non-existent comment
+	_, err = client.ReplyToComment(ctx, gofakeit.UUID(), authorId, gofakeit.HipsterSentence(10), nil)
+	if err == nil {
+		t.Error("Expected error for non-existent comment, got nil")
+	}
+}
+
 func TestGetComments(t *testing.T) {
 	// Test on correct data
 	const (
@@ -554,38 +596,40 @@ func TestGetFeed(t *testing.T) {
 	const (
 		validUserId = "2e9ee190-217c-4f92-aea4-b8086501fbb2" // Example valid user ID
 		validAmount = 10                                     // Example valid amount
+		latitude    = 55.751244
+		longitude   = 37.618423
 	)
 
 	// Test on correct data
-	feed, err := client.GetFeed(ctx, validUserId, validAmount)
+	feed, err := client.GetFeed(ctx, validUserId, latitude, longitude, validAmount)
 	if err != nil {
 		t.Fatal(err)
 	}
 	fmt.Println(feed)
 
 	// Test on invalid userId
-	_, err = client.GetFeed(ctx, "invalidId", validAmount)
+	_, err = client.GetFeed(ctx, "invalidId", latitude, longitude, validAmount)
 	if err == nil {
 		t.Fatal("Expected an error for invalid userId, got nil")
 	}
 	fmt.Println(err)
 
 	// Test on negative amount
-	_, err = client.GetFeed(ctx, validUserId, -1)
+	_, err = client.GetFeed(ctx, validUserId, latitude, longitude, -1)
 	if err == nil {
 		t.Fatal("Expected an error for negative amount, got nil")
 	}
 	fmt.Println(err)
 
 	// Test on zero amount
-	_, err = client.GetFeed(ctx, validUserId, 0)
+	_, err = client.GetFeed(ctx, validUserId, latitude, longitude, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Test on excessively large amount
 	// Assuming there's a maximum limit, adjust the value accordingly
-	_, err = client.GetFeed(ctx, validUserId, 1000)
+	_, err = client.GetFeed(ctx, validUserId, latitude, longitude, 1000)
 	if err == nil {
 		t.Fatal("Expected an error for excessively large amount, got nil")
 	}
@@ -0,0 +1,132 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recommendationMethods get the Recommendations budget of Timeouts
+var recommendationMethods = map[string]bool{
+	"RecommendLandmarks": true,
+	"TestGetRecommended": true,
+}
+
+// Timeouts are the deadlines calls get when their context has none. Zero
+// durations leave calls without a deadline
+type Timeouts struct {
+	// Default applies to methods without a more specific budget
+	Default time.Duration
+	// Recommendations applies to RecommendLandmarks and TestGetRecommended,
+	// which usually need longer than the other calls
+	Recommendations time.Duration
+	// Methods overrides the budget of single methods, keyed by method name,
+	// e.g. "GetComments"
+	Methods map[string]time.Duration
+}
+
+// budget returns the timeout of a method and the name of the budget it
+// comes from
+func (t Timeouts) budget(method string) (time.Duration, string) {
+	if d, ok := t.Methods[method]; ok {
+		return d, method
+	}
+	if recommendationMethods[method] && t.Recommendations > 0 {
+		return t.Recommendations, "recommendations"
+	}
+	return t.Default, "default"
+}
+
+var ErrTimeout = errors.New("call timed out")
+
+// TimeoutError is returned when a call runs out of a default deadline set
+// by Timeouts. It matches ErrTimeout and context.DeadlineExceeded, and
+// reports the status of the underlying error
+type TimeoutError struct {
+	Method  string
+	Budget  string
+	Timeout time.Duration
+	Err     error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s: %s budget of %s exceeded: %s", e.Method, e.Budget, e.Timeout, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout || target == context.DeadlineExceeded
+}
+
+func (e *TimeoutError) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+type deadlines struct {
+	timeouts Timeouts
+}
+
+func (d deadlines) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(d.unary),
+		grpc.WithChainStreamInterceptor(d.stream),
+	}
+}
+
+// withBudget applies the budget of method to ctx unless ctx already has a
+// deadline. The returned error wrapper names the budget when it fired
+func (d deadlines) withBudget(ctx context.Context, method string) (context.Context, context.CancelFunc, func(error) error) {
+	_, name := splitMethod(method)
+	timeout, budget := d.timeouts.budget(name)
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return ctx, func() {}, func(err error) error { return err }
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, func(err error) error {
+		if err == nil || status.Code(err) != codes.DeadlineExceeded || ctx.Err() != context.DeadlineExceeded {
+			return err
+		}
+		return &TimeoutError{Method: method, Budget: budget, Timeout: timeout, Err: err}
+	}
+}
+
+func (d deadlines) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, cancel, wrap := d.withBudget(ctx, method)
+	defer cancel()
+	return wrap(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func (d deadlines) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, cancel, wrap := d.withBudget(ctx, method)
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, wrap(err)
+	}
+	return &deadlineStream{
+		finishedStream: newFinishedStream(ctx, s, desc, func(error) { cancel() }),
+		wrap:           wrap,
+	}, nil
+}
+
+// deadlineStream releases the deadline of a stream once it ends
+type deadlineStream struct {
+	*finishedStream
+	wrap func(error) error
+}
+
+func (s *deadlineStream) SendMsg(m any) error {
+	return s.wrap(s.finishedStream.SendMsg(m))
+}
+
+func (s *deadlineStream) RecvMsg(m any) error {
+	return s.wrap(s.finishedStream.RecvMsg(m))
+}
@@ -0,0 +1,63 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimeoutsBudget(t *testing.T) {
+	timeouts := Timeouts{
+		Default:         time.Second,
+		Recommendations: 5 * time.Second,
+		Methods:         map[string]time.Duration{"GetComments": 2 * time.Second},
+	}
+	for method, want := range map[string]string{
+		"GetLandmark":        "default",
+		"RecommendLandmarks": "recommendations",
+		"TestGetRecommended": "recommendations",
+		"GetComments":        "GetComments",
+	} {
+		if _, budget := timeouts.budget(method); budget != want {
+			t.Errorf("%s: expected the %s budget, got %s", method, want, budget)
+		}
+	}
+}
+
+func TestDeadlines(t *testing.T) {
+	d := deadlines{Timeouts{Default: time.Hour, Recommendations: 10 * time.Millisecond}}
+	var deadline time.Time
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, _ = ctx.Deadline()
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	err := d.unary(context.Background(), "/storage.StorageService/RecommendLandmarks", nil, nil, nil, invoker)
+	if !errors.Is(err, ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) || status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "recommendations budget of 10ms") {
+		t.Errorf("expected the error to name the budget, got %q", err)
+	}
+	if time.Until(deadline) > 0 {
+		t.Errorf("the recommendations budget was not applied")
+	}
+
+	// a deadline of the caller is left alone and not attributed to a budget
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	want, _ := ctx.Deadline()
+	err = d.unary(ctx, "/storage.StorageService/GetLandmark", nil, nil, nil, invoker)
+	if !deadline.Equal(want) {
+		t.Errorf("expected the caller's deadline %v, got %v", want, deadline)
+	}
+	if errors.Is(err, ErrTimeout) {
+		t.Errorf("the caller's deadline was reported as a budget: %v", err)
+	}
+}
@@ -1,6 +1,11 @@
 package lrpc
 
 import (
+	"fmt"
+	"time"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"github.com/google/uuid"
 	"github.com/valyala/fastjson"
 )
 
@@ -17,6 +22,15 @@ type Comment struct {
 	Text        string
 	ReplyId     string
 	Timestamp   int
+	// EditedAt is 0 if the comment has never been edited
+	EditedAt  int
+	Deleted   bool
+	Revisions int
+	// Reaction counts, and the reaction of the user the comments were
+	// fetched for
+	HelpfulCount int
+	LikeCount    int
+	UserReaction ReactionKind
 }
 
 func (c Comment) JSON() []byte {
@@ -31,6 +45,16 @@ func (c Comment) JSON() []byte {
 
 	obj.Set("Grade", p.NewNumberInt(c.Grade))
 	obj.Set("Timestamp", p.NewNumberInt(c.Timestamp))
+	obj.Set("EditedAt", p.NewNumberInt(c.EditedAt))
+	obj.Set("Revisions", p.NewNumberInt(c.Revisions))
+	if c.Deleted {
+		obj.Set("Deleted", p.NewTrue())
+	} else {
+		obj.Set("Deleted", p.NewFalse())
+	}
+	obj.Set("HelpfulCount", p.NewNumberInt(c.HelpfulCount))
+	obj.Set("LikeCount", p.NewNumberInt(c.LikeCount))
+	obj.Set("UserReaction", p.NewString(c.UserReaction.String()))
 
 	attachments := p.NewArray()
 	for _, attachment := range c.Attachments {
@@ -41,6 +65,59 @@ func (c Comment) JSON() []byte {
 	return obj.MarshalTo(nil)
 }
 
+func commentFromStorage(v *storage.Comment) *Comment {
+	return &Comment{
+		Id:          v.Id,
+		ParentId:    v.ParentId,
+		UserId:      v.UserId,
+		Grade:       int(v.Grade),
+		Attachments: v.Attachments,
+		Text:        v.Text,
+		ReplyId:     v.ReplyId,
+		Timestamp:   int(v.Timestamp),
+		EditedAt:    int(v.EditedAt),
+		Deleted:     v.Deleted,
+		Revisions:   int(v.Revisions),
+
+		HelpfulCount: int(v.HelpfulCount),
+		LikeCount:    int(v.LikeCount),
+		UserReaction: ReactionKind(v.UserReaction),
+	}
+}
+
+type ReactionKind int
+
+const (
+	ReactionNone ReactionKind = iota
+	ReactionHelpful
+	ReactionLike
+)
+
+func (k ReactionKind) String() string {
+	switch k {
+	case ReactionNone:
+		return "none"
+	case ReactionHelpful:
+		return "helpful"
+	case ReactionLike:
+		return "like"
+	}
+	return fmt.Sprintf("ReactionKind(%d)", int(k))
+}
+
+type CommentReaction struct {
+	UserId    string
+	Kind      ReactionKind
+	Timestamp int
+}
+
+// CommentRevision is a past or the current text of a comment
+type CommentRevision struct {
+	Text      string
+	Timestamp int
+	EditorId  string
+}
+
 type LandmarkPreview struct {
 	Id     string
 	Liked  bool
@@ -62,6 +139,41 @@ func (l LandmarkPreview) JSON() []byte {
 	return obj.MarshalTo(nil)
 }
 
+// RatingSummary aggregates the grades of a landmark's reviews
+type RatingSummary struct {
+	LandmarkId string
+	// Histogram[i] is the number of reviews with grade i+1
+	Histogram           [5]int
+	Count               int
+	Mean                float64
+	BayesianMean        float64
+	RecencyWeightedMean float64
+}
+
+// BayesianMeanWith returns the mean shrunk towards prior as if weight
+// reviews with the prior grade were added, for callers that want a
+// different prior than the storage service uses
+func (r RatingSummary) BayesianMeanWith(prior, weight float64) float64 {
+	if float64(r.Count)+weight == 0 {
+		return 0
+	}
+	return (prior*weight + r.Mean*float64(r.Count)) / (weight + float64(r.Count))
+}
+
+func ratingSummaryFromStorage(v *storage.RatingSummary) *RatingSummary {
+	r := &RatingSummary{
+		LandmarkId:          v.LandmarkId,
+		Count:               int(v.Count),
+		Mean:                v.Mean,
+		BayesianMean:        v.BayesianMean,
+		RecencyWeightedMean: v.RecencyWeightedMean,
+	}
+	for i := 0; i < len(r.Histogram) && i < len(v.Histogram); i++ {
+		r.Histogram[i] = int(v.Histogram[i])
+	}
+	return r
+}
+
 type TagWithScore struct {
 	Id    string
 	Score float64
@@ -74,3 +186,88 @@ type LandmarkItem struct {
 	Longitude float64
 	Tags      []string
 }
+
+type LandmarkItems []*LandmarkItem
+
+// DefaultLandmarkTagScore is the score used for tags that come without one
+const DefaultLandmarkTagScore = 1
+
+// LandmarkSpec describes a landmark to be created, e.g. one read from a
+// GeoJSON file
+type LandmarkSpec struct {
+	Id          string
+	Score       float64
+	Coordinates Coordinates
+	Tags        []string
+}
+
+// FriendSuggestion is a second-degree contact along with the reasons it
+// was suggested
+type FriendSuggestion struct {
+	UserId        string
+	MutualFriends []string
+	SharedTags    []string
+	Score         float64
+}
+
+type FriendRequestState int
+
+const (
+	// FriendRequestUnspecified is the state of requests from servers that
+	// did not set one
+	FriendRequestUnspecified FriendRequestState = iota
+	FriendRequestPending
+	FriendRequestAccepted
+	FriendRequestDeclined
+	FriendRequestCancelled
+)
+
+func (s FriendRequestState) String() string {
+	switch s {
+	case FriendRequestUnspecified:
+		return "unspecified"
+	case FriendRequestPending:
+		return "pending"
+	case FriendRequestAccepted:
+		return "accepted"
+	case FriendRequestDeclined:
+		return "declined"
+	case FriendRequestCancelled:
+		return "cancelled"
+	}
+	return fmt.Sprintf("FriendRequestState(%d)", int(s))
+}
+
+type FriendRequest struct {
+	Id        string
+	Sender    string
+	Receiver  string
+	State     FriendRequestState
+	Timestamp int
+}
+
+// FriendFavourite is a landmark recently liked by one of the user's friends
+type FriendFavourite struct {
+	FriendId   uuid.UUID
+	LandmarkId uuid.UUID
+	LikedAt    time.Time
+}
+
+// LandmarkFavourites groups the friends who liked the same landmark, e.g.
+// "3 friends liked X". Landmark is only set if the group was hydrated
+type LandmarkFavourites struct {
+	LandmarkId  uuid.UUID
+	FriendIds   []uuid.UUID
+	LastLikedAt time.Time
+	Landmark    *LandmarkPreview
+}
+
+type AttachmentMeta struct {
+	Id          string
+	UploaderId  string
+	ContentType string
+	Size        int64
+	// Sha256 is the hex encoded checksum of the contents
+	Sha256     string
+	UploadedAt time.Time
+}
@@ -0,0 +1,26 @@
+package lrpc
+
+import (
+	"testing"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+)
+
+func TestRatingSummary(t *testing.T) {
+	r := ratingSummaryFromStorage(&storage.RatingSummary{
+		LandmarkId: "8e20a909-1a4c-43a3-b1d2-2f4505ec19fe",
+		Histogram:  []int64{0, 0, 0, 1, 1},
+		Count:      2,
+		Mean:       4.5,
+	})
+	if r.Histogram != [5]int{0, 0, 0, 1, 1} {
+		t.Errorf("unexpected histogram %v", r.Histogram)
+	}
+	// two 4.5 reviews and eight prior reviews of 3
+	if got := r.BayesianMeanWith(3, 8); got != 3.3 {
+		t.Errorf("expected 3.3, got %v", got)
+	}
+	if got := (RatingSummary{}).BayesianMeanWith(3, 0); got != 0 {
+		t.Errorf("expected 0 without reviews and prior, got %v", got)
+	}
+}
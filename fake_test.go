@@ -0,0 +1,672 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	feed "github.com/emalak/lrpc/rpc/feed"
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStorage is an in-memory stand-in for the storage service. Methods
+// that are not overridden panic on the nil embedded interface
+type fakeStorage struct {
+	storage.StorageServiceClient
+
+	mu    sync.Mutex
+	calls map[string]int
+	// tag graph, edges are stored in both directions
+	tags     map[string]map[string]float64
+	tagNames map[string]string
+	// landmark id to tag id to score
+	landmarkTags map[string]map[string]float64
+
+	userTags        map[string][]string
+	userTagsVersion map[string]int64
+	// onChangeUserTags runs before every ChangeUserTags, e.g. to simulate
+	// a concurrent edit
+	onChangeUserTags func()
+
+	friends map[string]map[string]bool
+	// legacyFriends makes the friend graph RPCs, e.g. SuggestFriends,
+	// unimplemented like on servers that predate them
+	legacyFriends bool
+	// friend requests in the order they were sent, and blocked users by
+	// the id of the user who blocked them
+	friendRequests []*storage.FriendRequest
+	blocks         map[string]map[string]bool
+	// favourites of friends by user id, most recent first
+	friendFavourites map[string][]*storage.FriendLikedLandmark
+
+	// comments by landmark id, in the order GetComments pages through them
+	comments map[string][]*storage.Comment
+	// ignoreOffset makes GetComments return the first page every time
+	ignoreOffset bool
+	// reactions by comment id, in the order they were first made
+	reactions map[string][]*storage.CommentReaction
+
+	// results of RecommendLandmarks and GetRandomFeed
+	recommended    []string
+	recommendedErr error
+	random         []string
+
+	attachments map[string]*storage.AttachmentMeta
+	// sizes of the chunks of every upload
+	uploadChunks [][]int
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{
+		calls:        map[string]int{},
+		tags:         map[string]map[string]float64{},
+		tagNames:     map[string]string{},
+		landmarkTags: map[string]map[string]float64{},
+
+		userTags:        map[string][]string{},
+		userTagsVersion: map[string]int64{},
+
+		friends:          map[string]map[string]bool{},
+		blocks:           map[string]map[string]bool{},
+		friendFavourites: map[string][]*storage.FriendLikedLandmark{},
+		comments:         map[string][]*storage.Comment{},
+		reactions:        map[string][]*storage.CommentReaction{},
+
+		attachments: map[string]*storage.AttachmentMeta{},
+	}
+}
+
+func (f *fakeStorage) client() *Client {
+	return &Client{Storage: &Storage{Client: f}}
+}
+
+func (f *fakeStorage) called(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[method]++
+}
+
+func (f *fakeStorage) connect(id1, id2 string, score float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range []string{id1, id2} {
+		if f.tags[id] == nil {
+			f.tags[id] = map[string]float64{}
+		}
+	}
+	f.tags[id1][id2] = score
+	f.tags[id2][id1] = score
+}
+
+func (f *fakeStorage) GetConnectedTags(ctx context.Context, in *storage.GetConnectedTagsRequest, opts ...grpc.CallOption) (*storage.GetConnectedTagsResponse, error) {
+	f.called("GetConnectedTags")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.tags[in.TagId] == nil {
+		return nil, status.Error(codes.NotFound, "tag not found")
+	}
+	res := &storage.GetConnectedTagsResponse{}
+	for id, score := range f.tags[in.TagId] {
+		res.Tags = append(res.Tags, &storage.Tag{Id: id, Score: float32(score)})
+	}
+	return res, nil
+}
+
+func (f *fakeStorage) CreateTag(ctx context.Context, in *storage.CreateTagRequest, opts ...grpc.CallOption) (*storage.CreateTagResponse, error) {
+	f.called("CreateTag")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.tags[in.Id] != nil {
+		return nil, status.Error(codes.AlreadyExists, "tag exists")
+	}
+	f.tags[in.Id] = map[string]float64{}
+	return &storage.CreateTagResponse{}, nil
+}
+
+func (f *fakeStorage) ConnectTags(ctx context.Context, in *storage.ConnectTagsRequest, opts ...grpc.CallOption) (*storage.ConnectTagsResponse, error) {
+	f.called("ConnectTags")
+	f.connect(in.Id1, in.Id2, float64(in.Score))
+	return &storage.ConnectTagsResponse{}, nil
+}
+
+func (f *fakeStorage) DisconnectTags(ctx context.Context, in *storage.DisconnectTagsRequest, opts ...grpc.CallOption) (*storage.DisconnectTagsResponse, error) {
+	f.called("DisconnectTags")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.tags[in.Id1], in.Id2)
+	delete(f.tags[in.Id2], in.Id1)
+	return &storage.DisconnectTagsResponse{}, nil
+}
+
+func (f *fakeStorage) DeleteTag(ctx context.Context, in *storage.DeleteTagRequest, opts ...grpc.CallOption) (*storage.DeleteTagResponse, error) {
+	f.called("DeleteTag")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for id := range f.tags[in.Id] {
+		delete(f.tags[id], in.Id)
+	}
+	delete(f.tags, in.Id)
+	return &storage.DeleteTagResponse{}, nil
+}
+
+func (f *fakeStorage) SetNodeName(ctx context.Context, in *storage.SetNodeNameRequest, opts ...grpc.CallOption) (*storage.SetNodeNameResponse, error) {
+	f.called("SetNodeName")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tagNames[in.Id] = in.Name
+	return &storage.SetNodeNameResponse{}, nil
+}
+
+func (f *fakeStorage) GetLandmarkTagsWithScore(ctx context.Context, in *storage.GetLandmarkTagsWithScoreRequest, opts ...grpc.CallOption) (*storage.GetLandmarkTagsWithScoreResponse, error) {
+	f.called("GetLandmarkTagsWithScore")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := &storage.GetLandmarkTagsWithScoreResponse{}
+	for id, score := range f.landmarkTags[in.Id] {
+		res.Tags = append(res.Tags, &storage.TagIdScore{TagId: id, Score: float32(score)})
+	}
+	return res, nil
+}
+
+func (f *fakeStorage) GetUserTags(ctx context.Context, in *storage.GetUserTagsRequest, opts ...grpc.CallOption) (*storage.GetUserTagsResponse, error) {
+	f.called("GetUserTags")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &storage.GetUserTagsResponse{
+		Ids:     append([]string(nil), f.userTags[in.UserId]...),
+		Version: f.userTagsVersion[in.UserId],
+	}, nil
+}
+
+func (f *fakeStorage) ChangeUserTags(ctx context.Context, in *storage.ChangeUserTagsRequest, opts ...grpc.CallOption) (*storage.ChangeUserTagsResponse, error) {
+	f.called("ChangeUserTags")
+	if f.onChangeUserTags != nil {
+		f.onChangeUserTags()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if in.ExpectedVersion != nil && *in.ExpectedVersion != f.userTagsVersion[in.UserId] {
+		return nil, status.Error(codes.Aborted, "version mismatch")
+	}
+	f.userTags[in.UserId] = in.Tags
+	f.userTagsVersion[in.UserId]++
+	return &storage.ChangeUserTagsResponse{Version: f.userTagsVersion[in.UserId]}, nil
+}
+
+func (f *fakeStorage) befriend(user1, user2 string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range []string{user1, user2} {
+		if f.friends[id] == nil {
+			f.friends[id] = map[string]bool{}
+		}
+	}
+	f.friends[user1][user2] = true
+	f.friends[user2][user1] = true
+}
+
+func (f *fakeStorage) GetFriends(ctx context.Context, in *storage.GetFriendsRequest, opts ...grpc.CallOption) (*storage.GetFriendsResponse, error) {
+	f.called("GetFriends")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := &storage.GetFriendsResponse{}
+	for id := range f.friends[in.UserId] {
+		res.Ids = append(res.Ids, id)
+	}
+	sort.Strings(res.Ids)
+	return res, nil
+}
+
+func (f *fakeStorage) SuggestFriends(ctx context.Context, in *storage.SuggestFriendsRequest, opts ...grpc.CallOption) (*storage.SuggestFriendsResponse, error) {
+	f.called("SuggestFriends")
+	if f.legacyFriends {
+		return nil, status.Error(codes.Unimplemented, "not implemented")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	mutual := map[string][]string{}
+	for friend := range f.friends[in.UserId] {
+		for contact := range f.friends[friend] {
+			if contact != in.UserId && !f.friends[in.UserId][contact] && !f.blocked(in.UserId, contact) {
+				mutual[contact] = append(mutual[contact], friend)
+			}
+		}
+	}
+	res := &storage.SuggestFriendsResponse{}
+	for contact, via := range mutual {
+		sort.Strings(via)
+		res.Suggestions = append(res.Suggestions, &storage.FriendSuggestion{
+			UserId:        contact,
+			MutualFriends: via,
+			SharedTags:    intersectStrings(f.userTags[in.UserId], f.userTags[contact]),
+			Score:         float32(len(via)),
+		})
+	}
+	sort.Slice(res.Suggestions, func(i, j int) bool {
+		a, b := res.Suggestions[i], res.Suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.UserId < b.UserId
+	})
+	if len(res.Suggestions) > int(in.Limit) {
+		res.Suggestions = res.Suggestions[:in.Limit]
+	}
+	return res, nil
+}
+
+func (f *fakeStorage) MutualFriends(ctx context.Context, in *storage.MutualFriendsRequest, opts ...grpc.CallOption) (*storage.MutualFriendsResponse, error) {
+	f.called("MutualFriends")
+	if f.legacyFriends {
+		return nil, status.Error(codes.Unimplemented, "not implemented")
+	}
+	return &storage.MutualFriendsResponse{Ids: f.mutualFriends(in.User1, in.User2)}, nil
+}
+
+func (f *fakeStorage) CountMutualFriends(ctx context.Context, in *storage.CountMutualFriendsRequest, opts ...grpc.CallOption) (*storage.CountMutualFriendsResponse, error) {
+	f.called("CountMutualFriends")
+	if f.legacyFriends {
+		return nil, status.Error(codes.Unimplemented, "not implemented")
+	}
+	return &storage.CountMutualFriendsResponse{Count: int32(len(f.mutualFriends(in.User1, in.User2)))}, nil
+}
+
+func (f *fakeStorage) mutualFriends(user1, user2 string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var ids []string
+	for id := range f.friends[user1] {
+		if f.friends[user2][id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (f *fakeStorage) AreFriends(ctx context.Context, in *storage.AreFriendsRequest, opts ...grpc.CallOption) (*storage.AreFriendsResponse, error) {
+	f.called("AreFriends")
+	if f.legacyFriends {
+		return nil, status.Error(codes.Unimplemented, "not implemented")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := &storage.AreFriendsResponse{IsFriend: make([]bool, len(in.Candidates))}
+	for i, candidate := range in.Candidates {
+		res.IsFriend[i] = f.friends[in.UserId][candidate]
+	}
+	return res, nil
+}
+
+// blocked must be called with f.mu held
+func (f *fakeStorage) blocked(user1, user2 string) bool {
+	return f.blocks[user1][user2] || f.blocks[user2][user1]
+}
+
+func (f *fakeStorage) SendFriendRequest(ctx context.Context, in *storage.SendFriendRequestRequest, opts ...grpc.CallOption) (*storage.SendFriendRequestResponse, error) {
+	f.called("SendFriendRequest")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if in.Sender == "" {
+		return nil, status.Error(codes.PermissionDenied, "unauthenticated sender")
+	}
+	if f.blocked(in.Sender, in.Receiver) {
+		s, err := status.New(codes.PermissionDenied, "blocked").WithDetails(&errdetails.ErrorInfo{Reason: userBlockedReason})
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Err()
+	}
+	req := &storage.FriendRequest{
+		Id:        fmt.Sprintf("request-%d", len(f.friendRequests)+1),
+		Sender:    in.Sender,
+		Receiver:  in.Receiver,
+		State:     storage.FriendRequestState_FRIEND_REQUEST_PENDING,
+		Timestamp: int64(len(f.friendRequests) + 1),
+	}
+	f.friendRequests = append(f.friendRequests, req)
+	return &storage.SendFriendRequestResponse{Request: req}, nil
+}
+
+func (f *fakeStorage) pendingFriendRequests(in *storage.GetFriendRequestsRequest, incoming bool) *storage.GetFriendRequestsResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := &storage.GetFriendRequestsResponse{}
+	for _, req := range f.friendRequests {
+		user := req.Sender
+		if incoming {
+			user = req.Receiver
+		}
+		if user == in.UserId && req.State == storage.FriendRequestState_FRIEND_REQUEST_PENDING {
+			res.Requests = append(res.Requests, req)
+		}
+	}
+	return res
+}
+
+func (f *fakeStorage) GetIncomingFriendRequests(ctx context.Context, in *storage.GetFriendRequestsRequest, opts ...grpc.CallOption) (*storage.GetFriendRequestsResponse, error) {
+	f.called("GetIncomingFriendRequests")
+	return f.pendingFriendRequests(in, true), nil
+}
+
+func (f *fakeStorage) GetOutgoingFriendRequests(ctx context.Context, in *storage.GetFriendRequestsRequest, opts ...grpc.CallOption) (*storage.GetFriendRequestsResponse, error) {
+	f.called("GetOutgoingFriendRequests")
+	return f.pendingFriendRequests(in, false), nil
+}
+
+// respondFriendRequest moves a pending request to state if userId is the
+// receiver, or the sender when bySender is set
+func (f *fakeStorage) respondFriendRequest(in *storage.RespondFriendRequestRequest, bySender bool, state storage.FriendRequestState) (*storage.FriendRequest, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, req := range f.friendRequests {
+		if req.Id != in.RequestId {
+			continue
+		}
+		party := req.Receiver
+		if bySender {
+			party = req.Sender
+		}
+		if party != in.UserId {
+			return nil, status.Error(codes.PermissionDenied, "not a party of the request")
+		}
+		if req.State != storage.FriendRequestState_FRIEND_REQUEST_PENDING {
+			return nil, status.Error(codes.FailedPrecondition, "request is not pending")
+		}
+		req.State = state
+		return req, nil
+	}
+	return nil, status.Error(codes.NotFound, "no such request")
+}
+
+func (f *fakeStorage) AcceptFriendRequest(ctx context.Context, in *storage.RespondFriendRequestRequest, opts ...grpc.CallOption) (*storage.RespondFriendRequestResponse, error) {
+	f.called("AcceptFriendRequest")
+	req, err := f.respondFriendRequest(in, false, storage.FriendRequestState_FRIEND_REQUEST_ACCEPTED)
+	if err != nil {
+		return nil, err
+	}
+	f.befriend(req.Sender, req.Receiver)
+	return &storage.RespondFriendRequestResponse{}, nil
+}
+
+func (f *fakeStorage) DeclineFriendRequest(ctx context.Context, in *storage.RespondFriendRequestRequest, opts ...grpc.CallOption) (*storage.RespondFriendRequestResponse, error) {
+	f.called("DeclineFriendRequest")
+	if _, err := f.respondFriendRequest(in, false, storage.FriendRequestState_FRIEND_REQUEST_DECLINED); err != nil {
+		return nil, err
+	}
+	return &storage.RespondFriendRequestResponse{}, nil
+}
+
+func (f *fakeStorage) CancelFriendRequest(ctx context.Context, in *storage.RespondFriendRequestRequest, opts ...grpc.CallOption) (*storage.RespondFriendRequestResponse, error) {
+	f.called("CancelFriendRequest")
+	if _, err := f.respondFriendRequest(in, true, storage.FriendRequestState_FRIEND_REQUEST_CANCELLED); err != nil {
+		return nil, err
+	}
+	return &storage.RespondFriendRequestResponse{}, nil
+}
+
+func (f *fakeStorage) BlockUser(ctx context.Context, in *storage.BlockUserRequest, opts ...grpc.CallOption) (*storage.BlockUserResponse, error) {
+	f.called("BlockUser")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.blocks[in.UserId] == nil {
+		f.blocks[in.UserId] = map[string]bool{}
+	}
+	f.blocks[in.UserId][in.BlockedId] = true
+	delete(f.friends[in.UserId], in.BlockedId)
+	delete(f.friends[in.BlockedId], in.UserId)
+	for _, req := range f.friendRequests {
+		users := []string{req.Sender, req.Receiver}
+		if req.State == storage.FriendRequestState_FRIEND_REQUEST_PENDING &&
+			slices.Contains(users, in.UserId) && slices.Contains(users, in.BlockedId) {
+			req.State = storage.FriendRequestState_FRIEND_REQUEST_CANCELLED
+		}
+	}
+	return &storage.BlockUserResponse{}, nil
+}
+
+func (f *fakeStorage) UnblockUser(ctx context.Context, in *storage.BlockUserRequest, opts ...grpc.CallOption) (*storage.BlockUserResponse, error) {
+	f.called("UnblockUser")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.blocks[in.UserId], in.BlockedId)
+	return &storage.BlockUserResponse{}, nil
+}
+
+func (f *fakeStorage) GetBlockedUsers(ctx context.Context, in *storage.GetBlockedUsersRequest, opts ...grpc.CallOption) (*storage.GetBlockedUsersResponse, error) {
+	f.called("GetBlockedUsers")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	res := &storage.GetBlockedUsersResponse{}
+	for id := range f.blocks[in.UserId] {
+		res.Ids = append(res.Ids, id)
+	}
+	sort.Strings(res.Ids)
+	return res, nil
+}
+
+func (f *fakeStorage) IsBlocked(ctx context.Context, in *storage.IsBlockedRequest, opts ...grpc.CallOption) (*storage.IsBlockedResponse, error) {
+	f.called("IsBlocked")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &storage.IsBlockedResponse{IsBlocked: f.blocked(in.User1, in.User2)}, nil
+}
+
+func (f *fakeStorage) GetRecentFriendsFavourites(ctx context.Context, in *storage.GetRecentFriendsFavouritesRequest, opts ...grpc.CallOption) (*storage.GetRecentFriendsFavouritesResponse, error) {
+	f.called("GetRecentFriendsFavourites")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all := f.friendFavourites[in.UserId]
+	from := min(int(in.Offset), len(all))
+	to := min(from+int(in.Limit), len(all))
+	return &storage.GetRecentFriendsFavouritesResponse{Result: all[from:to]}, nil
+}
+
+func (f *fakeStorage) GetComments(ctx context.Context, in *storage.GetCommentsRequest, opts ...grpc.CallOption) (*storage.GetCommentsResponse, error) {
+	f.called("GetComments")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var all []*storage.Comment
+	for _, comment := range f.comments[in.LandmarkId] {
+		if !comment.Deleted || in.IncludeDeleted {
+			all = append(all, comment)
+		}
+	}
+	from := min(int(in.Offset), len(all))
+	if f.ignoreOffset {
+		from = 0
+	}
+	to := min(from+int(in.Limit), len(all))
+	return &storage.GetCommentsResponse{Comments: all[from:to]}, nil
+}
+
+func (f *fakeStorage) ReactToComment(ctx context.Context, in *storage.ReactToCommentRequest, opts ...grpc.CallOption) (*storage.ReactToCommentResponse, error) {
+	f.called("ReactToComment")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	timestamp := int64(f.calls["ReactToComment"])
+	for _, reaction := range f.reactions[in.CommentId] {
+		if reaction.UserId == in.UserId {
+			reaction.Kind = in.Kind
+			reaction.Timestamp = timestamp
+			return &storage.ReactToCommentResponse{}, nil
+		}
+	}
+	f.reactions[in.CommentId] = append(f.reactions[in.CommentId], &storage.CommentReaction{
+		UserId:    in.UserId,
+		Kind:      in.Kind,
+		Timestamp: timestamp,
+	})
+	return &storage.ReactToCommentResponse{}, nil
+}
+
+func (f *fakeStorage) UnreactToComment(ctx context.Context, in *storage.UnreactToCommentRequest, opts ...grpc.CallOption) (*storage.UnreactToCommentResponse, error) {
+	f.called("UnreactToComment")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reactions[in.CommentId] = slices.DeleteFunc(f.reactions[in.CommentId], func(r *storage.CommentReaction) bool {
+		return r.UserId == in.UserId
+	})
+	return &storage.UnreactToCommentResponse{}, nil
+}
+
+func (f *fakeStorage) GetCommentReactions(ctx context.Context, in *storage.GetCommentReactionsRequest, opts ...grpc.CallOption) (*storage.GetCommentReactionsResponse, error) {
+	f.called("GetCommentReactions")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all := f.reactions[in.CommentId]
+	from := min(int(in.Offset), len(all))
+	to := min(from+int(in.Limit), len(all))
+	return &storage.GetCommentReactionsResponse{Reactions: all[from:to]}, nil
+}
+
+func (f *fakeStorage) CreateComment(ctx context.Context, in *storage.CreateCommentRequest, opts ...grpc.CallOption) (*storage.CreateCommentResponse, error) {
+	f.called("CreateComment")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := uuid.NewString()
+	timestamp := time.Now().Unix()
+	f.comments[in.ParentId] = append(f.comments[in.ParentId], &storage.Comment{
+		Id:          id,
+		ParentId:    in.ParentId,
+		UserId:      in.AuthorId,
+		Grade:       int64(in.Rating),
+		Attachments: in.Attachments,
+		Text:        in.Text,
+		ReplyId:     in.ReplyId,
+		Timestamp:   timestamp,
+	})
+	return &storage.CreateCommentResponse{Id: id, Timestamp: timestamp}, nil
+}
+
+func (f *fakeStorage) EditComment(ctx context.Context, in *storage.EditCommentRequest, opts ...grpc.CallOption) (*storage.EditCommentResponse, error) {
+	f.called("EditComment")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, comments := range f.comments {
+		for _, comment := range comments {
+			if comment.Id == in.CommentId && comment.UserId == in.UserId {
+				comment.Text = in.Text
+				return &storage.EditCommentResponse{}, nil
+			}
+		}
+	}
+	return nil, status.Error(codes.NotFound, "comment not found")
+}
+
+func (f *fakeStorage) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (storage.StorageService_UploadAttachmentClient, error) {
+	f.called("UploadAttachment")
+	return &fakeUpload{f: f}, nil
+}
+
+// fakeUpload collects an upload and checks it once the stream is closed
+type fakeUpload struct {
+	grpc.ClientStream
+
+	f      *fakeStorage
+	info   *storage.AttachmentInfo
+	data   bytes.Buffer
+	chunks []int
+	sum    string
+}
+
+func (u *fakeUpload) Send(in *storage.UploadAttachmentRequest) error {
+	switch p := in.Payload.(type) {
+	case *storage.UploadAttachmentRequest_Info:
+		u.info = p.Info
+	case *storage.UploadAttachmentRequest_Chunk:
+		u.data.Write(p.Chunk)
+		u.chunks = append(u.chunks, len(p.Chunk))
+	case *storage.UploadAttachmentRequest_Sha256:
+		u.sum = p.Sha256
+	}
+	return nil
+}
+
+func (u *fakeUpload) CloseAndRecv() (*storage.UploadAttachmentResponse, error) {
+	sum := sha256.Sum256(u.data.Bytes())
+	if u.info == nil || hex.EncodeToString(sum[:]) != u.sum {
+		return nil, status.Error(codes.DataLoss, "checksum mismatch")
+	}
+	u.f.mu.Lock()
+	defer u.f.mu.Unlock()
+	id := uuid.NewString()
+	u.f.attachments[id] = &storage.AttachmentMeta{
+		Id:          id,
+		UploaderId:  u.info.UploaderId,
+		ContentType: u.info.ContentType,
+		Size:        int64(u.data.Len()),
+		Sha256:      u.sum,
+		Timestamp:   time.Now().Unix(),
+	}
+	u.f.uploadChunks = append(u.f.uploadChunks, u.chunks)
+	return &storage.UploadAttachmentResponse{Id: id, Size: int64(u.data.Len())}, nil
+}
+
+func (f *fakeStorage) GetAttachmentMeta(ctx context.Context, in *storage.GetAttachmentMetaRequest, opts ...grpc.CallOption) (*storage.GetAttachmentMetaResponse, error) {
+	f.called("GetAttachmentMeta")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	meta, ok := f.attachments[in.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "attachment not found")
+	}
+	return &storage.GetAttachmentMetaResponse{Meta: meta}, nil
+}
+
+func (f *fakeStorage) RecommendLandmarks(ctx context.Context, in *storage.RecommendLandmarksRequest, opts ...grpc.CallOption) (*storage.RecommendLandmarksResponse, error) {
+	f.called("RecommendLandmarks")
+	if f.recommendedErr != nil {
+		return nil, f.recommendedErr
+	}
+	return &storage.RecommendLandmarksResponse{Ids: f.recommended}, nil
+}
+
+func (f *fakeStorage) GetRandomFeed(ctx context.Context, in *storage.GetRandomFeedRequest, opts ...grpc.CallOption) (*storage.GetRandomFeedResponse, error) {
+	f.called("GetRandomFeed")
+	return &storage.GetRandomFeedResponse{Ids: f.random}, nil
+}
+
+// fakeFeed is a feed service that answers every GetFeed the same way
+type fakeFeed struct {
+	feed.LandmarkFeedClient
+
+	ids []string
+	err error
+	// hang makes GetFeed wait for its context
+	hang bool
+}
+
+func (f *fakeFeed) GetFeed(ctx context.Context, in *feed.GetFeedRequest, opts ...grpc.CallOption) (*feed.GetFeedResponse, error) {
+	if f.hang {
+		<-ctx.Done()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &feed.GetFeedResponse{LandmarkIds: f.ids}, nil
+}
+
+// interceptedConn passes unary calls through interceptor before invoke,
+// to test interceptors without a server
+type interceptedConn struct {
+	grpc.ClientConnInterface
+	interceptor grpc.UnaryClientInterceptor
+	invoke      grpc.UnaryInvoker
+}
+
+func (c interceptedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return c.interceptor(ctx, method, args, reply, nil, c.invoke, opts...)
+}
@@ -0,0 +1,70 @@
+package lrpc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// landmarkHydrationConcurrency caps the GetLandmark calls in flight
+const landmarkHydrationConcurrency = 8
+
+// GroupFriendFavourites groups favourites by landmark. Groups are ordered
+// by their most recent like, friends within a group by their like, most
+// recent first. A friend who liked a landmark twice is listed once
+func GroupFriendFavourites(favourites []*FriendFavourite) []*LandmarkFavourites {
+	sorted := make([]*FriendFavourite, len(favourites))
+	copy(sorted, favourites)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LikedAt.After(sorted[j].LikedAt)
+	})
+	var groups []*LandmarkFavourites
+	byLandmark := map[uuid.UUID]*LandmarkFavourites{}
+	for _, f := range sorted {
+		group, ok := byLandmark[f.LandmarkId]
+		if !ok {
+			group = &LandmarkFavourites{LandmarkId: f.LandmarkId, LastLikedAt: f.LikedAt}
+			byLandmark[f.LandmarkId] = group
+			groups = append(groups, group)
+		}
+		duplicate := false
+		for _, id := range group.FriendIds {
+			duplicate = duplicate || id == f.FriendId
+		}
+		if !duplicate {
+			group.FriendIds = append(group.FriendIds, f.FriendId)
+		}
+	}
+	return groups
+}
+
+// HydrateLandmarkFavourites sets Landmark on every group, as seen by the
+// given user
+func (c *Client) HydrateLandmarkFavourites(ctx context.Context, userId string, groups []*LandmarkFavourites) error {
+	return forEachConcurrent(ctx, len(groups), landmarkHydrationConcurrency, func(ctx context.Context, i int) error {
+		landmark, err := c.GetLandmark(ctx, groups[i].LandmarkId.String(), userId)
+		if err != nil {
+			return err
+		}
+		groups[i].Landmark = landmark
+		return nil
+	})
+}
+
+// GetRecentFriendsFavouritesByLandmark is GetRecentFriendsFavourites grouped
+// by landmark, optionally hydrated with landmark previews. Paging applies
+// to the underlying likes, not to the groups
+func (c *Client) GetRecentFriendsFavouritesByLandmark(ctx context.Context, userId string, limit, offset int, hydrate bool) ([]*LandmarkFavourites, error) {
+	favourites, err := c.GetRecentFriendsFavourites(ctx, userId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	groups := GroupFriendFavourites(favourites)
+	if hydrate {
+		if err = c.HydrateLandmarkFavourites(ctx, userId, groups); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,65 @@
+package lrpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"github.com/google/uuid"
+)
+
+func TestGroupFriendFavourites(t *testing.T) {
+	var (
+		alice, bob         = uuid.New(), uuid.New()
+		kremlin, hermitage = uuid.New(), uuid.New()
+		now                = time.Unix(1718000000, 0)
+	)
+	groups := GroupFriendFavourites([]*FriendFavourite{
+		{FriendId: alice, LandmarkId: kremlin, LikedAt: now.Add(-3 * time.Hour)},
+		{FriendId: bob, LandmarkId: hermitage, LikedAt: now.Add(-2 * time.Hour)},
+		{FriendId: bob, LandmarkId: kremlin, LikedAt: now},
+		{FriendId: bob, LandmarkId: kremlin, LikedAt: now.Add(-time.Hour)},
+	})
+	want := []*LandmarkFavourites{
+		{LandmarkId: kremlin, FriendIds: []uuid.UUID{bob, alice}, LastLikedAt: now},
+		{LandmarkId: hermitage, FriendIds: []uuid.UUID{bob}, LastLikedAt: now.Add(-2 * time.Hour)},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("expected %+v, got %+v", want, groups)
+	}
+}
+
+func TestGetRecentFriendsFavourites(t *testing.T) {
+	var (
+		friend   = uuid.MustParse("0b9c3d3e-2c5e-4d4b-9e57-8c1a0c6a1f01")
+		landmark = uuid.MustParse("712d6060-284b-400b-805d-cc118cd41c5d")
+	)
+	f := newFakeStorage()
+	f.friendFavourites["user"] = []*storage.FriendLikedLandmark{
+		{FriendId: friend.String(), LandmarkId: landmark.String(), Timestamp: 1700000000},
+	}
+	f.friendFavourites["broken"] = []*storage.FriendLikedLandmark{
+		{FriendId: friend.String(), LandmarkId: "not a uuid", Timestamp: 1700000000},
+	}
+	client := f.client()
+
+	favourites, err := client.GetRecentFriendsFavourites(context.Background(), "user", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*FriendFavourite{{FriendId: friend, LandmarkId: landmark, LikedAt: time.Unix(1700000000, 0)}}
+	if !reflect.DeepEqual(favourites, want) {
+		t.Errorf("expected %+v, got %+v", want[0], favourites)
+	}
+
+	favourites, err = client.GetRecentFriendsFavourites(context.Background(), "nobody", 10, 0)
+	if err != nil || favourites != nil {
+		t.Errorf("expected no favourites, got %v %v", favourites, err)
+	}
+
+	if _, err = client.GetRecentFriendsFavourites(context.Background(), "broken", 10, 0); err == nil {
+		t.Error("expected an error for an invalid landmark id")
+	}
+}
@@ -0,0 +1,99 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type FeedSource int
+
+const (
+	FeedSourceNone FeedSource = iota
+	FeedSourceFeed
+	FeedSourceRecommendations
+	FeedSourceRandom
+)
+
+func (s FeedSource) String() string {
+	switch s {
+	case FeedSourceNone:
+		return "none"
+	case FeedSourceFeed:
+		return "feed"
+	case FeedSourceRecommendations:
+		return "recommendations"
+	case FeedSourceRandom:
+		return "random"
+	}
+	return fmt.Sprintf("FeedSource(%d)", int(s))
+}
+
+var ErrNoFeedSource = errors.New("no feed source is configured")
+
+type FallbackFeed struct {
+	LandmarkIds []string
+	// Source served LandmarkIds, FeedSourceNone if every source that
+	// answered had nothing
+	Source FeedSource
+	// Failures are the errors of the sources tried before Source
+	Failures []error
+}
+
+// FeedWithFallback gets the user's feed from the feed service and falls
+// back to storage recommendations, then to random landmarks, when the
+// service is not configured, fails or has no landmarks for the user. If ctx
+// has a deadline every source gets an equal share of the time left, so a
+// hanging source leaves time for the next ones, otherwise the configured
+// Timeouts apply to each call. An error is returned only when every source
+// failed or ctx ended, then along with the failures so far
+func (c *Client) FeedWithFallback(ctx context.Context, userId string, coords Coordinates, amount int) (*FallbackFeed, error) {
+	type source struct {
+		kind FeedSource
+		get  func(ctx context.Context) ([]string, error)
+	}
+	var sources []source
+	if c.Feed != nil {
+		sources = append(sources, source{FeedSourceFeed, func(ctx context.Context) ([]string, error) {
+			return c.GetFeed(ctx, userId, coords.Latitude, coords.Longitude, amount)
+		}})
+	}
+	if c.Storage != nil {
+		sources = append(sources, source{FeedSourceRecommendations, func(ctx context.Context) ([]string, error) {
+			return c.RecommendLandmarks(ctx, userId, coords.Latitude, coords.Longitude, amount)
+		}}, source{FeedSourceRandom, func(ctx context.Context) ([]string, error) {
+			return c.GetRandomFeed(ctx, amount)
+		}})
+	}
+	if len(sources) == 0 {
+		return nil, ErrNoFeedSource
+	}
+	res := &FallbackFeed{}
+	answered := false
+	for i, s := range sources {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Join(append(res.Failures, err)...)
+		}
+		sourceCtx, cancel := ctx, context.CancelFunc(func() {})
+		if deadline, ok := ctx.Deadline(); ok {
+			sourceCtx, cancel = context.WithTimeout(ctx, time.Until(deadline)/time.Duration(len(sources)-i))
+		}
+		ids, err := s.get(sourceCtx)
+		cancel()
+		if err != nil {
+			res.Failures = append(res.Failures, fmt.Errorf("%s: %w", s.kind, err))
+			continue
+		}
+		answered = true
+		if len(ids) > 0 {
+			res.LandmarkIds = ids
+			res.Source = s.kind
+			return res, nil
+		}
+	}
+	if !answered {
+		return nil, errors.Join(res.Failures...)
+	}
+	return res, nil
+}
@@ -0,0 +1,145 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	feed "github.com/emalak/lrpc/rpc/feed"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFeedWithFallback(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	for _, tc := range []struct {
+		name     string
+		feed     *fakeFeed
+		storage  *fakeStorage
+		want     []string
+		source   FeedSource
+		failures int
+	}{
+		{
+			name:    "feed",
+			feed:    &fakeFeed{ids: []string{"f"}},
+			storage: &fakeStorage{recommended: []string{"r"}},
+			want:    []string{"f"},
+			source:  FeedSourceFeed,
+		},
+		{
+			name:     "failed feed",
+			feed:     &fakeFeed{err: unavailable},
+			storage:  &fakeStorage{recommended: []string{"r"}},
+			want:     []string{"r"},
+			source:   FeedSourceRecommendations,
+			failures: 1,
+		},
+		{
+			name:    "no feed service",
+			storage: &fakeStorage{recommended: []string{"r"}},
+			want:    []string{"r"},
+			source:  FeedSourceRecommendations,
+		},
+		{
+			name:     "empty feed and failed recommendations",
+			feed:     &fakeFeed{},
+			storage:  &fakeStorage{recommendedErr: unavailable, random: []string{"x"}},
+			want:     []string{"x"},
+			source:   FeedSourceRandom,
+			failures: 1,
+		},
+		{
+			name:    "nothing anywhere",
+			feed:    &fakeFeed{},
+			storage: &fakeStorage{},
+			source:  FeedSourceNone,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.storage.calls = map[string]int{}
+			c := tc.storage.client()
+			if tc.feed != nil {
+				c.Feed = &Feed{Client: tc.feed}
+			}
+			res, err := c.FeedWithFallback(context.Background(), "user", Coordinates{}, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(res.LandmarkIds, tc.want) || res.Source != tc.source || len(res.Failures) != tc.failures {
+				t.Errorf("expected %v from %s after %d failures, got %+v", tc.want, tc.source, tc.failures, res)
+			}
+		})
+	}
+}
+
+func TestFeedWithFallbackFailed(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	c := &Client{Feed: &Feed{Client: &fakeFeed{err: unavailable}}}
+	_, err := c.FeedWithFallback(context.Background(), "user", Coordinates{}, 10)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("expected the feed error, got %v", err)
+	}
+
+	_, err = (&Client{}).FeedWithFallback(context.Background(), "user", Coordinates{}, 10)
+	if !errors.Is(err, ErrNoFeedSource) {
+		t.Errorf("expected ErrNoFeedSource, got %v", err)
+	}
+}
+
+func TestFeedWithFallbackHangingFeed(t *testing.T) {
+	c := (&fakeStorage{calls: map[string]int{}, recommended: []string{"r"}}).client()
+	c.Feed = &Feed{Client: &fakeFeed{hang: true}}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	res, err := c.FeedWithFallback(ctx, "user", Coordinates{}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Source != FeedSourceRecommendations || len(res.Failures) != 1 || status.Code(res.Failures[0]) != codes.DeadlineExceeded {
+		t.Errorf("expected recommendations after the feed timed out, got %+v", res)
+	}
+}
+
+func TestFeedWithFallbackTimeouts(t *testing.T) {
+	d := deadlines{timeouts: Timeouts{Methods: map[string]time.Duration{"GetFeed": 50 * time.Millisecond}}}
+	hang := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	c := (&fakeStorage{calls: map[string]int{}, recommended: []string{"r"}}).client()
+	c.Feed = &Feed{Client: feed.NewLandmarkFeedClient(interceptedConn{interceptor: d.unary, invoke: hang})}
+
+	// without a deadline of the caller the GetFeed budget applies
+	res, err := c.FeedWithFallback(context.Background(), "user", Coordinates{}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var timeout *TimeoutError
+	if res.Source != FeedSourceRecommendations || len(res.Failures) != 1 || !errors.As(res.Failures[0], &timeout) || timeout.Budget != "GetFeed" {
+		t.Errorf("expected recommendations after the GetFeed budget ran out, got %+v", res)
+	}
+}
+
+func TestFeedWithFallbackCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fail := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		cancel()
+		return status.Error(codes.Unavailable, "down")
+	}
+	c := (&fakeStorage{calls: map[string]int{}, recommended: []string{"r"}}).client()
+	c.Feed = &Feed{Client: feed.NewLandmarkFeedClient(interceptedConn{interceptor: deadlines{}.unary, invoke: fail})}
+
+	_, err := c.FeedWithFallback(ctx, "user", Coordinates{}, 10)
+	if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), "feed: ") {
+		t.Errorf("expected the feed failure along with the context error, got %v", err)
+	}
+	if c.Storage.Client.(*fakeStorage).calls["RecommendLandmarks"] != 0 {
+		t.Error("expected no calls after the context ended")
+	}
+}
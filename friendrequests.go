@@ -0,0 +1,162 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUserBlocked is returned when sending a friend request between users
+// where one has blocked the other. It wraps the status of the server
+var ErrUserBlocked = errors.New("user is blocked")
+
+// userBlockedReason is the ErrorInfo reason storage refuses friend
+// requests with when a user is blocked
+const userBlockedReason = "USER_BLOCKED"
+
+// isUserBlocked tells a refused friend request apart from other
+// PermissionDenied errors, e.g. failed authorization
+func isUserBlocked(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.PermissionDenied {
+		return false
+	}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == userBlockedReason {
+			return true
+		}
+	}
+	return false
+}
+
+func friendRequestFromStorage(v *storage.FriendRequest) *FriendRequest {
+	return &FriendRequest{
+		Id:        v.Id,
+		Sender:    v.Sender,
+		Receiver:  v.Receiver,
+		State:     FriendRequestState(v.State),
+		Timestamp: int(v.Timestamp),
+	}
+}
+
+func friendRequestsFromStorage(in []*storage.FriendRequest) []*FriendRequest {
+	if len(in) == 0 {
+		return nil
+	}
+	requests := make([]*FriendRequest, len(in))
+	for i, v := range in {
+		requests[i] = friendRequestFromStorage(v)
+	}
+	return requests
+}
+
+// SendFriendRequest creates a pending friend request, the friendship is
+// only created once the receiver accepts it. Unlike AddFriend it requires
+// consent and respects blocks
+func (c *Client) SendFriendRequest(ctx context.Context, sender, receiver string) (*FriendRequest, error) {
+	res, err := c.Storage.Client.SendFriendRequest(ctx, &storage.SendFriendRequestRequest{
+		Sender:   sender,
+		Receiver: receiver,
+	})
+	if isUserBlocked(err) {
+		return nil, fmt.Errorf("%w: %w", ErrUserBlocked, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return friendRequestFromStorage(res.Request), nil
+}
+
+func (c *Client) GetIncomingFriendRequests(ctx context.Context, userId string, limit, offset int) ([]*FriendRequest, error) {
+	res, err := c.Storage.Client.GetIncomingFriendRequests(ctx, &storage.GetFriendRequestsRequest{
+		UserId: userId,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return friendRequestsFromStorage(res.Requests), nil
+}
+
+func (c *Client) GetOutgoingFriendRequests(ctx context.Context, userId string, limit, offset int) ([]*FriendRequest, error) {
+	res, err := c.Storage.Client.GetOutgoingFriendRequests(ctx, &storage.GetFriendRequestsRequest{
+		UserId: userId,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return friendRequestsFromStorage(res.Requests), nil
+}
+
+// AcceptFriendRequest is called by the receiver and creates the friendship
+func (c *Client) AcceptFriendRequest(ctx context.Context, requestId, userId string) error {
+	_, err := c.Storage.Client.AcceptFriendRequest(ctx, &storage.RespondFriendRequestRequest{
+		RequestId: requestId,
+		UserId:    userId,
+	})
+	return err
+}
+
+// DeclineFriendRequest is called by the receiver
+func (c *Client) DeclineFriendRequest(ctx context.Context, requestId, userId string) error {
+	_, err := c.Storage.Client.DeclineFriendRequest(ctx, &storage.RespondFriendRequestRequest{
+		RequestId: requestId,
+		UserId:    userId,
+	})
+	return err
+}
+
+// CancelFriendRequest is called by the sender
+func (c *Client) CancelFriendRequest(ctx context.Context, requestId, userId string) error {
+	_, err := c.Storage.Client.CancelFriendRequest(ctx, &storage.RespondFriendRequestRequest{
+		RequestId: requestId,
+		UserId:    userId,
+	})
+	return err
+}
+
+// BlockUser removes the friendship and pending requests between the users
+// and hides them from each other's IsFriend and GetRecentFriendsFavourites
+func (c *Client) BlockUser(ctx context.Context, userId, blockedId string) error {
+	_, err := c.Storage.Client.BlockUser(ctx, &storage.BlockUserRequest{
+		UserId:    userId,
+		BlockedId: blockedId,
+	})
+	return err
+}
+
+func (c *Client) UnblockUser(ctx context.Context, userId, blockedId string) error {
+	_, err := c.Storage.Client.UnblockUser(ctx, &storage.BlockUserRequest{
+		UserId:    userId,
+		BlockedId: blockedId,
+	})
+	return err
+}
+
+func (c *Client) GetBlockedUsers(ctx context.Context, userId string) ([]string, error) {
+	res, err := c.Storage.Client.GetBlockedUsers(ctx, &storage.GetBlockedUsersRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	return res.Ids, nil
+}
+
+// IsBlocked reports whether either user has blocked the other
+func (c *Client) IsBlocked(ctx context.Context, user1, user2 string) (bool, error) {
+	res, err := c.Storage.Client.IsBlocked(ctx, &storage.IsBlockedRequest{
+		User1: user1,
+		User2: user2,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IsBlocked, nil
+}
@@ -0,0 +1,292 @@
+package lrpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// friendsConcurrency caps the storage calls in flight during fan-out
+	friendsConcurrency = 8
+	// suggestFriendsCandidates caps the second-degree contacts whose tags
+	// are fetched by the client-side SuggestFriends
+	suggestFriendsCandidates = 100
+)
+
+// SuggestFriends returns up to limit friends of the user's friends ranked
+// by the number of mutual friends and then by the overlap of their tags.
+// The user, their friends and users blocked either way are never
+// suggested. The storage service computes the suggestions if it supports
+// it, otherwise they are built client side from GetFriends and GetUserTags
+func (c *Client) SuggestFriends(ctx context.Context, userId string, limit int) ([]FriendSuggestion, error) {
+	res, err := c.Storage.Client.SuggestFriends(ctx, &storage.SuggestFriendsRequest{
+		UserId: userId,
+		Limit:  int32(limit),
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return c.suggestFriends(ctx, userId, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Suggestions) == 0 {
+		return nil, nil
+	}
+	suggestions := make([]FriendSuggestion, len(res.Suggestions))
+	for i, v := range res.Suggestions {
+		suggestions[i] = FriendSuggestion{
+			UserId:        v.UserId,
+			MutualFriends: v.MutualFriends,
+			SharedTags:    v.SharedTags,
+			Score:         float64(v.Score),
+		}
+	}
+	return suggestions, nil
+}
+
+func (c *Client) suggestFriends(ctx context.Context, userId string, limit int) ([]FriendSuggestion, error) {
+	friends, err := c.GetFriends(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool, len(friends)+1)
+	excluded[userId] = true
+	for _, friend := range friends {
+		excluded[friend] = true
+	}
+
+	var mu sync.Mutex
+	mutual := map[string][]string{}
+	err = forEachConcurrent(ctx, len(friends), friendsConcurrency, func(ctx context.Context, i int) error {
+		contacts, err := c.GetFriends(ctx, friends[i])
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, contact := range contacts {
+			if !excluded[contact] {
+				mutual[contact] = append(mutual[contact], friends[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]FriendSuggestion, 0, len(mutual))
+	for contact, via := range mutual {
+		sort.Strings(via)
+		suggestions = append(suggestions, FriendSuggestion{UserId: contact, MutualFriends: via})
+	}
+	sortFriendSuggestions(suggestions)
+	if len(suggestions) > suggestFriendsCandidates {
+		suggestions = suggestions[:suggestFriendsCandidates]
+	}
+
+	candidates := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		candidates[i] = s.UserId
+	}
+	blocked, err := c.blockedAmong(ctx, userId, candidates)
+	if err != nil {
+		return nil, err
+	}
+	suggestions = slices.DeleteFunc(suggestions, func(s FriendSuggestion) bool {
+		return blocked[s.UserId]
+	})
+
+	userTags, err := c.GetUserTags(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	err = forEachConcurrent(ctx, len(suggestions), friendsConcurrency, func(ctx context.Context, i int) error {
+		tags, err := c.GetUserTags(ctx, suggestions[i].UserId)
+		if err != nil {
+			return err
+		}
+		s := &suggestions[i]
+		s.SharedTags = intersectStrings(userTags, tags)
+		s.Score = float64(len(s.MutualFriends)) + jaccard(len(s.SharedTags), len(userTags), len(tags))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortFriendSuggestions(suggestions)
+	if limit >= 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// MutualFriends returns the friends two users have in common. Servers
+// without the MutualFriends RPC are served with two GetFriends calls
+func (c *Client) MutualFriends(ctx context.Context, user1, user2 string) ([]string, error) {
+	res, err := c.Storage.Client.MutualFriends(ctx, &storage.MutualFriendsRequest{
+		User1: user1,
+		User2: user2,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return c.mutualFriends(ctx, user1, user2)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.Ids, nil
+}
+
+// CountMutualFriends returns the number of friends two users have in
+// common. Servers without the CountMutualFriends RPC are served with two
+// GetFriends calls
+func (c *Client) CountMutualFriends(ctx context.Context, user1, user2 string) (int, error) {
+	res, err := c.Storage.Client.CountMutualFriends(ctx, &storage.CountMutualFriendsRequest{
+		User1: user1,
+		User2: user2,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		ids, err := c.mutualFriends(ctx, user1, user2)
+		return len(ids), err
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(res.Count), nil
+}
+
+func (c *Client) mutualFriends(ctx context.Context, user1, user2 string) ([]string, error) {
+	friends := make([][]string, 2)
+	users := []string{user1, user2}
+	err := forEachConcurrent(ctx, len(users), len(users), func(ctx context.Context, i int) error {
+		var err error
+		friends[i], err = c.GetFriends(ctx, users[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return intersectStrings(friends[0], friends[1]), nil
+}
+
+// AreFriends reports for each candidate whether they are friends with the
+// user, replacing a call to IsFriend per candidate. Like IsFriend it
+// reports users blocked either way as not friends. Servers without the
+// AreFriends RPC are served with GetFriends and an IsBlocked call per
+// friend among the candidates
+func (c *Client) AreFriends(ctx context.Context, userId string, candidates []string) (map[string]bool, error) {
+	res, err := c.Storage.Client.AreFriends(ctx, &storage.AreFriendsRequest{
+		UserId:     userId,
+		Candidates: candidates,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return c.areFriends(ctx, userId, candidates)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(res.IsFriend) != len(candidates) {
+		return nil, fmt.Errorf("AreFriends: expected %d results, got %d", len(candidates), len(res.IsFriend))
+	}
+	friends := make(map[string]bool, len(candidates))
+	for i, candidate := range candidates {
+		friends[candidate] = res.IsFriend[i]
+	}
+	return friends, nil
+}
+
+func (c *Client) areFriends(ctx context.Context, userId string, candidates []string) (map[string]bool, error) {
+	ids, err := c.GetFriends(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		all[id] = true
+	}
+	var found []string
+	for _, candidate := range candidates {
+		if all[candidate] {
+			found = append(found, candidate)
+		}
+	}
+	blocked, err := c.blockedAmong(ctx, userId, found)
+	if err != nil {
+		return nil, err
+	}
+	friends := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		friends[candidate] = all[candidate] && !blocked[candidate]
+	}
+	return friends, nil
+}
+
+// blockedAmong reports which of ids have blocked the user or were blocked
+// by them. Servers without blocking report nobody as blocked
+func (c *Client) blockedAmong(ctx context.Context, userId string, ids []string) (map[string]bool, error) {
+	results := make([]bool, len(ids))
+	err := forEachConcurrent(ctx, len(ids), friendsConcurrency, func(ctx context.Context, i int) error {
+		var err error
+		results[i], err = c.IsBlocked(ctx, userId, ids[i])
+		return err
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		if results[i] {
+			blocked[id] = true
+		}
+	}
+	return blocked, nil
+}
+
+// sortFriendSuggestions orders by mutual friends, then shared tags, then
+// by user id so that the result is stable
+func sortFriendSuggestions(s []FriendSuggestion) {
+	sort.Slice(s, func(i, j int) bool {
+		if len(s[i].MutualFriends) != len(s[j].MutualFriends) {
+			return len(s[i].MutualFriends) > len(s[j].MutualFriends)
+		}
+		if s[i].Score != s[j].Score {
+			return s[i].Score > s[j].Score
+		}
+		return s[i].UserId < s[j].UserId
+	})
+}
+
+// intersectStrings returns the elements of a that are also in b, in the
+// order of a
+func intersectStrings(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var res []string
+	for _, v := range a {
+		if in[v] {
+			res = append(res, v)
+			delete(in, v)
+		}
+	}
+	return res
+}
+
+func jaccard(common, a, b int) float64 {
+	union := a + b - common
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
@@ -0,0 +1,282 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSuggestFriends(t *testing.T) {
+	f := newFakeStorage()
+	f.legacyFriends = true
+	f.befriend("me", "alice")
+	f.befriend("me", "bob")
+	f.befriend("alice", "bob")
+	f.befriend("alice", "carol")
+	f.befriend("bob", "carol")
+	f.befriend("alice", "dave")
+	f.befriend("bob", "erin")
+	f.userTags["me"] = []string{"art", "music"}
+	f.userTags["erin"] = []string{"music"}
+	f.userTags["dave"] = []string{"food"}
+	client := f.client()
+
+	suggestions, err := client.SuggestFriends(context.Background(), "me", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []FriendSuggestion{
+		{UserId: "carol", MutualFriends: []string{"alice", "bob"}, Score: 2},
+		{UserId: "erin", MutualFriends: []string{"bob"}, SharedTags: []string{"music"}, Score: 1.5},
+	}
+	if !reflect.DeepEqual(suggestions, want) {
+		t.Errorf("expected %+v, got %+v", want, suggestions)
+	}
+}
+
+func TestSuggestFriendsServer(t *testing.T) {
+	f := newFakeStorage()
+	f.befriend("me", "alice")
+	f.befriend("me", "bob")
+	f.befriend("alice", "carol")
+	f.befriend("bob", "carol")
+	f.befriend("bob", "erin")
+	f.userTags["me"] = []string{"art", "music"}
+	f.userTags["erin"] = []string{"music"}
+	client := f.client()
+
+	suggestions, err := client.SuggestFriends(context.Background(), "me", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []FriendSuggestion{
+		{UserId: "carol", MutualFriends: []string{"alice", "bob"}, Score: 2},
+		{UserId: "erin", MutualFriends: []string{"bob"}, SharedTags: []string{"music"}, Score: 1},
+	}
+	if !reflect.DeepEqual(suggestions, want) {
+		t.Errorf("expected %+v, got %+v", want, suggestions)
+	}
+	if f.calls["GetFriends"] != 0 {
+		t.Error("expected no client-side fallback")
+	}
+
+	suggestions, err = client.SuggestFriends(context.Background(), "dave", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suggestions != nil {
+		t.Errorf("expected no suggestions, got %+v", suggestions)
+	}
+}
+
+func TestSuggestFriendsSkipsBlocked(t *testing.T) {
+	f := newFakeStorage()
+	f.legacyFriends = true
+	f.befriend("me", "alice")
+	f.befriend("alice", "carol")
+	f.befriend("alice", "dave")
+	f.befriend("alice", "erin")
+	client := f.client()
+	if err := client.BlockUser(context.Background(), "carol", "me"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.BlockUser(context.Background(), "me", "dave"); err != nil {
+		t.Fatal(err)
+	}
+
+	suggestions, err := client.SuggestFriends(context.Background(), "me", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(suggestions) != 1 || suggestions[0].UserId != "erin" {
+		t.Errorf("expected only erin to be suggested, got %+v", suggestions)
+	}
+}
+
+func TestMutualFriends(t *testing.T) {
+	for _, legacy := range []bool{false, true} {
+		f := newFakeStorage()
+		f.legacyFriends = legacy
+		f.befriend("alice", "carol")
+		f.befriend("alice", "dave")
+		f.befriend("bob", "carol")
+		f.befriend("bob", "dave")
+		f.befriend("bob", "erin")
+		client := f.client()
+
+		ids, err := client.MutualFriends(context.Background(), "alice", "bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"carol", "dave"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("legacy %v: expected %v, got %v", legacy, want, ids)
+		}
+		count, err := client.CountMutualFriends(context.Background(), "alice", "bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Errorf("legacy %v: expected 2 mutual friends, got %d", legacy, count)
+		}
+		// the fallback is served with GetFriends only
+		if fallback := f.calls["GetFriends"] > 0; fallback != legacy {
+			t.Errorf("legacy %v: expected the client-side fallback to be used %v", legacy, legacy)
+		}
+	}
+}
+
+func TestAreFriends(t *testing.T) {
+	f := newFakeStorage()
+	f.befriend("alice", "bob")
+	f.befriend("alice", "carol")
+	client := f.client()
+
+	friends, err := client.AreFriends(context.Background(), "alice", []string{"bob", "dave", "carol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"bob": true, "carol": true, "dave": false}
+	if !reflect.DeepEqual(friends, want) {
+		t.Errorf("expected %v, got %v", want, friends)
+	}
+}
+
+func TestAreFriendsFallbackSkipsBlocked(t *testing.T) {
+	f := newFakeStorage()
+	f.legacyFriends = true
+	f.befriend("alice", "bob")
+	client := f.client()
+	if err := client.BlockUser(context.Background(), "carol", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	// added without consent after the block, as AddFriend does
+	f.befriend("alice", "carol")
+
+	friends, err := client.AreFriends(context.Background(), "alice", []string{"bob", "carol", "dave"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"bob": true, "carol": false, "dave": false}
+	if !reflect.DeepEqual(friends, want) {
+		t.Errorf("expected %v, got %v", want, friends)
+	}
+	if f.calls["IsBlocked"] != 2 {
+		t.Errorf("expected IsBlocked for the 2 friends only, got %d calls", f.calls["IsBlocked"])
+	}
+}
+
+func TestFriendRequests(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeStorage()
+	client := f.client()
+
+	accepted, err := client.SendFriendRequest(ctx, "alice", "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelled, err := client.SendFriendRequest(ctx, "carol", "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	declined, err := client.SendFriendRequest(ctx, "dave", "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accepted.State != FriendRequestPending || accepted.Sender != "alice" || accepted.Receiver != "bob" {
+		t.Errorf("unexpected request %+v", accepted)
+	}
+	incoming, err := client.GetIncomingFriendRequests(ctx, "bob", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(incoming) != 3 {
+		t.Fatalf("expected 3 incoming requests, got %d", len(incoming))
+	}
+
+	if err = client.AcceptFriendRequest(ctx, accepted.Id, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.CancelFriendRequest(ctx, cancelled.Id, "carol"); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.DeclineFriendRequest(ctx, declined.Id, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if friends, _ := client.GetFriends(ctx, "bob"); !reflect.DeepEqual(friends, []string{"alice"}) {
+		t.Errorf("expected bob to be friends with alice only, got %v", friends)
+	}
+	if incoming, _ = client.GetIncomingFriendRequests(ctx, "bob", 10, 0); len(incoming) != 0 {
+		t.Errorf("expected no pending requests, got %+v", incoming)
+	}
+}
+
+func TestBlockUser(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeStorage()
+	f.befriend("alice", "bob")
+	client := f.client()
+	pending, err := client.SendFriendRequest(ctx, "carol", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.BlockUser(ctx, "alice", "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.BlockUser(ctx, "alice", "carol"); err != nil {
+		t.Fatal(err)
+	}
+	if friends, _ := client.GetFriends(ctx, "alice"); len(friends) != 0 {
+		t.Errorf("expected the block to end the friendship, got %v", friends)
+	}
+	if outgoing, _ := client.GetOutgoingFriendRequests(ctx, "carol", 10, 0); len(outgoing) != 0 {
+		t.Errorf("expected the block to drop %s, got %+v", pending.Id, outgoing)
+	}
+	blocked, err := client.GetBlockedUsers(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(blocked, []string{"bob", "carol"}) {
+		t.Errorf("expected bob and carol to be blocked, got %v", blocked)
+	}
+	// blocks apply both ways
+	if ok, _ := client.IsBlocked(ctx, "bob", "alice"); !ok {
+		t.Error("expected bob and alice to be blocked")
+	}
+	for _, users := range [][2]string{{"bob", "alice"}, {"alice", "bob"}} {
+		_, err = client.SendFriendRequest(ctx, users[0], users[1])
+		if !errors.Is(err, ErrUserBlocked) || status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s to %s: expected ErrUserBlocked, got %v", users[0], users[1], err)
+		}
+	}
+	// other PermissionDenied errors are not blocks
+	if _, err = client.SendFriendRequest(ctx, "", "alice"); errors.Is(err, ErrUserBlocked) || status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected a plain PermissionDenied, got %v", err)
+	}
+
+	if err = client.UnblockUser(ctx, "alice", "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := client.IsBlocked(ctx, "bob", "alice"); ok {
+		t.Error("expected the block to be lifted")
+	}
+	if _, err = client.SendFriendRequest(ctx, "bob", "alice"); err != nil {
+		t.Errorf("expected a request after unblocking, got %v", err)
+	}
+}
+
+func TestFriendRequestState(t *testing.T) {
+	for v, name := range storage.FriendRequestState_name {
+		state := FriendRequestState(v)
+		name = strings.TrimPrefix(strings.TrimPrefix(name, "FRIEND_REQUEST_"), "STATE_")
+		if want := strings.ToLower(name); state.String() != want {
+			t.Errorf("expected %d to be %s, got %s", v, want, state)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package lrpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/valyala/fastjson"
+)
+
+// LandmarkItemIterator returns the next item on every call and io.EOF once
+// there are no items left
+type LandmarkItemIterator func() (*LandmarkItem, error)
+
+// Iterator returns an iterator over the items
+func (l LandmarkItems) Iterator() LandmarkItemIterator {
+	i := 0
+	return func() (*LandmarkItem, error) {
+		if i >= len(l) {
+			return nil, io.EOF
+		}
+		i++
+		return l[i-1], nil
+	}
+}
+
+// GeoJSON returns the items as a GeoJSON FeatureCollection of points with
+// score and tags as feature properties
+func (l LandmarkItems) GeoJSON() []byte {
+	var p fastjson.Arena
+	features := p.NewArray()
+	for i, item := range l {
+		features.SetArrayItem(i, geoJSONFeature(&p, item))
+	}
+	obj := p.NewObject()
+	obj.Set("type", p.NewString("FeatureCollection"))
+	obj.Set("features", features)
+	return obj.MarshalTo(nil)
+}
+
+// WriteGeoJSON writes a GeoJSON FeatureCollection to w feature by feature,
+// so the whole result set never has to be held in memory
+func WriteGeoJSON(w io.Writer, next LandmarkItemIterator) error {
+	if _, err := io.WriteString(w, `{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+	var (
+		p   fastjson.Arena
+		buf []byte
+	)
+	for i := 0; ; i++ {
+		item, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		buf = buf[:0]
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = geoJSONFeature(&p, item).MarshalTo(buf)
+		if _, err = w.Write(buf); err != nil {
+			return err
+		}
+		p.Reset()
+	}
+	_, err := io.WriteString(w, "]}")
+	return err
+}
+
+func geoJSONFeature(p *fastjson.Arena, item *LandmarkItem) *fastjson.Value {
+	coordinates := p.NewArray()
+	// GeoJSON positions are [longitude, latitude]
+	coordinates.SetArrayItem(0, p.NewNumberFloat64(item.Longitude))
+	coordinates.SetArrayItem(1, p.NewNumberFloat64(item.Latitude))
+	geometry := p.NewObject()
+	geometry.Set("type", p.NewString("Point"))
+	geometry.Set("coordinates", coordinates)
+
+	tags := p.NewArray()
+	for i, tag := range item.Tags {
+		tags.SetArrayItem(i, p.NewString(tag))
+	}
+	properties := p.NewObject()
+	properties.Set("score", p.NewNumberFloat64(item.Score))
+	properties.Set("tags", tags)
+
+	feature := p.NewObject()
+	feature.Set("type", p.NewString("Feature"))
+	feature.Set("id", p.NewString(item.Id))
+	feature.Set("geometry", geometry)
+	feature.Set("properties", properties)
+	return feature
+}
+
+// ReadGeoJSON reads a GeoJSON FeatureCollection of points, as written by
+// WriteGeoJSON, into landmark specs. The landmark id is taken from the
+// feature id or from the "id" property
+func ReadGeoJSON(r io.Reader) ([]LandmarkSpec, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var p fastjson.Parser
+	v, err := p.ParseBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if t := string(v.GetStringBytes("type")); t != "FeatureCollection" {
+		return nil, fmt.Errorf("geojson: expected FeatureCollection, got %q", t)
+	}
+	features := v.GetArray("features")
+	specs := make([]LandmarkSpec, len(features))
+	for i, f := range features {
+		spec, err := landmarkSpecFromFeature(f)
+		if err != nil {
+			return nil, fmt.Errorf("geojson: feature %d: %w", i, err)
+		}
+		specs[i] = spec
+	}
+	return specs, nil
+}
+
+func landmarkSpecFromFeature(f *fastjson.Value) (LandmarkSpec, error) {
+	if t := string(f.GetStringBytes("geometry", "type")); t != "Point" {
+		return LandmarkSpec{}, fmt.Errorf("expected Point geometry, got %q", t)
+	}
+	position := f.GetArray("geometry", "coordinates")
+	if len(position) < 2 {
+		return LandmarkSpec{}, errors.New("point has no coordinates")
+	}
+	longitude, err := position[0].Float64()
+	if err != nil {
+		return LandmarkSpec{}, err
+	}
+	latitude, err := position[1].Float64()
+	if err != nil {
+		return LandmarkSpec{}, err
+	}
+	id := string(f.GetStringBytes("id"))
+	if id == "" {
+		id = string(f.GetStringBytes("properties", "id"))
+	}
+	if id == "" {
+		return LandmarkSpec{}, errors.New("feature has no id")
+	}
+	var tags []string
+	for _, tag := range f.GetArray("properties", "tags") {
+		b, err := tag.StringBytes()
+		if err != nil {
+			return LandmarkSpec{}, err
+		}
+		tags = append(tags, string(b))
+	}
+	return LandmarkSpec{
+		Id:    id,
+		Score: f.GetFloat64("properties", "score"),
+		Coordinates: Coordinates{
+			Longitude: longitude,
+			Latitude:  latitude,
+		},
+		Tags: tags,
+	}, nil
+}
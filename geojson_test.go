@@ -0,0 +1,68 @@
+package lrpc
+
+import (
+	"bytes"
+	"github.com/brianvoe/gofakeit"
+	"reflect"
+	"testing"
+)
+
+func TestLandmarkItems_GeoJSON(t *testing.T) {
+	items := LandmarkItems{
+		{
+			Id:        gofakeit.UUID(),
+			Score:     0.75,
+			Latitude:  55.7512447,
+			Longitude: 37.6184237,
+			Tags:      []string{gofakeit.UUID(), gofakeit.UUID()},
+		},
+		{
+			Id:        gofakeit.UUID(),
+			Score:     2,
+			Latitude:  -33.8567844,
+			Longitude: 151.2152967,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteGeoJSON(&buf, items.Iterator()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), items.GeoJSON()) {
+		t.Fatalf("streamed and in-memory output differ:\n%s\n%s", buf.Bytes(), items.GeoJSON())
+	}
+
+	specs, err := ReadGeoJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(specs) != len(items) {
+		t.Fatalf("expected %d specs, got %d", len(items), len(specs))
+	}
+	for i, spec := range specs {
+		want := LandmarkSpec{
+			Id:    items[i].Id,
+			Score: items[i].Score,
+			Coordinates: Coordinates{
+				Longitude: items[i].Longitude,
+				Latitude:  items[i].Latitude,
+			},
+			Tags: items[i].Tags,
+		}
+		if !reflect.DeepEqual(spec, want) {
+			t.Errorf("expected %+v, got %+v", want, spec)
+		}
+	}
+}
+
+func TestReadGeoJSON_Invalid(t *testing.T) {
+	for _, in := range []string{
+		`{"type":"Feature"}`,
+		`{"type":"FeatureCollection","features":[{"type":"Feature","id":"a","geometry":{"type":"LineString","coordinates":[[1,2],[3,4]]}}]}`,
+		`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]}}]}`,
+	} {
+		if _, err := ReadGeoJSON(bytes.NewBufferString(in)); err == nil {
+			t.Errorf("expected an error for %s", in)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package lrpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type GraphFormat int
+
+const (
+	GraphDOT GraphFormat = iota
+	GraphML
+)
+
+type GraphExportOptions struct {
+	// Seeds are the tags the walk starts from
+	Seeds []string
+	// Depth limits the walk to that many hops from the seeds, 0 means
+	// the whole connected subgraph
+	Depth int
+	// MinScore drops tag edges scored below it
+	MinScore float64
+	// Landmarks whose tag edges, read with GetLandmarkTagsWithScore, are
+	// added to the graph
+	Landmarks []string
+	// Names are node labels by id, since names can't be read back from
+	// storage. Nodes without a name are labelled with their id
+	Names map[string]string
+}
+
+type graphNode struct {
+	id       string
+	landmark bool
+}
+
+type graphEdge struct {
+	from, to string
+	score    float64
+	landmark bool
+}
+
+// ExportTagGraph walks the tag graph around opts.Seeds and writes it to w
+// in the given format, with edge scores as weights
+func (c *Client) ExportTagGraph(ctx context.Context, w io.Writer, format GraphFormat, opts GraphExportOptions) error {
+	g, err := c.readTagGraph(ctx, opts.Seeds, &tagGraphFilter{depth: opts.Depth, minScore: opts.MinScore})
+	if err != nil {
+		return err
+	}
+	nodes := map[string]graphNode{}
+	for id := range g.tags {
+		nodes[id] = graphNode{id: id}
+	}
+	edges := make([]graphEdge, 0, len(g.edges))
+	for key, score := range g.edges {
+		nodes[key.id1] = graphNode{id: key.id1}
+		nodes[key.id2] = graphNode{id: key.id2}
+		edges = append(edges, graphEdge{from: key.id1, to: key.id2, score: shortestFloat32(score)})
+	}
+	for _, landmarkId := range opts.Landmarks {
+		tags, err := c.GetLandmarkTagsWithScore(ctx, landmarkId)
+		if err != nil {
+			return fmt.Errorf("landmark %s: %w", landmarkId, err)
+		}
+		nodes[landmarkId] = graphNode{id: landmarkId, landmark: true}
+		for _, tag := range tags {
+			if tag.Score < opts.MinScore {
+				continue
+			}
+			if _, ok := nodes[tag.Id]; !ok {
+				nodes[tag.Id] = graphNode{id: tag.Id}
+			}
+			edges = append(edges, graphEdge{from: landmarkId, to: tag.Id, score: shortestFloat32(tag.Score), landmark: true})
+		}
+	}
+
+	sortedNodes := make([]graphNode, 0, len(nodes))
+	for _, node := range nodes {
+		sortedNodes = append(sortedNodes, node)
+	}
+	sort.Slice(sortedNodes, func(i, j int) bool { return sortedNodes[i].id < sortedNodes[j].id })
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+		return edges[i].to < edges[j].to
+	})
+
+	switch format {
+	case GraphDOT:
+		return writeDOT(w, sortedNodes, edges, opts.Names)
+	case GraphML:
+		return writeGraphML(w, sortedNodes, edges, opts.Names)
+	}
+	return fmt.Errorf("unknown graph format %d", format)
+}
+
+func nodeLabel(names map[string]string, id string) string {
+	if name, ok := names[id]; ok && name != "" {
+		return name
+	}
+	return id
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func writeDOT(w io.Writer, nodes []graphNode, edges []graphEdge, names map[string]string) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("graph tags {\n")
+	for _, node := range nodes {
+		shape := "ellipse"
+		if node.landmark {
+			shape = "box"
+		}
+		fmt.Fprintf(b, "\t%s [label=%s, shape=%s];\n", dotQuote(node.id), dotQuote(nodeLabel(names, node.id)), shape)
+	}
+	for _, edge := range edges {
+		score := strconv.FormatFloat(edge.score, 'g', -1, 64)
+		style := "solid"
+		if edge.landmark {
+			style = "dashed"
+		}
+		fmt.Fprintf(b, "\t%s -- %s [weight=%s, label=%s, style=%s];\n", dotQuote(edge.from), dotQuote(edge.to), score, dotQuote(score), style)
+	}
+	b.WriteString("}\n")
+	return b.Flush()
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	Id   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func writeGraphML(w io.Writer, nodes []graphNode, edges []graphEdge, names map[string]string) error {
+	doc := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{Id: "name", For: "node", Name: "name", Type: "string"},
+			{Id: "kind", For: "node", Name: "kind", Type: "string"},
+			{Id: "weight", For: "edge", Name: "weight", Type: "double"},
+		},
+		Graph: graphMLGraph{Id: "tags", EdgeDefault: "undirected"},
+	}
+	for _, node := range nodes {
+		kind := "tag"
+		if node.landmark {
+			kind = "landmark"
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			Id: node.id,
+			Data: []graphMLData{
+				{Key: "name", Value: nodeLabel(names, node.id)},
+				{Key: "kind", Value: kind},
+			},
+		})
+	}
+	for _, edge := range edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: edge.from,
+			Target: edge.to,
+			Data:   []graphMLData{{Key: "weight", Value: strconv.FormatFloat(edge.score, 'g', -1, 64)}},
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
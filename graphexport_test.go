@@ -0,0 +1,61 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"testing"
+)
+
+func TestExportTagGraph_DOT(t *testing.T) {
+	f := newChainGraph()
+	f.landmarkTags["kremlin"] = map[string]float64{"a": 0.75}
+	client := f.client()
+
+	var buf bytes.Buffer
+	err := client.ExportTagGraph(context.Background(), &buf, GraphDOT, GraphExportOptions{
+		Seeds:     []string{"a"},
+		Depth:     1,
+		MinScore:  0.2,
+		Landmarks: []string{"kremlin"},
+		Names:     map[string]string{"a": `Art "classic"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `graph tags {
+	"a" [label="Art \"classic\"", shape=ellipse];
+	"b" [label="b", shape=ellipse];
+	"kremlin" [label="kremlin", shape=box];
+	"a" -- "b" [weight=0.5, label="0.5", style=solid];
+	"kremlin" -- "a" [weight=0.75, label="0.75", style=dashed];
+}
+`
+	if buf.String() != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestExportTagGraph_GraphML(t *testing.T) {
+	client := newChainGraph().client()
+
+	var buf bytes.Buffer
+	err := client.ExportTagGraph(context.Background(), &buf, GraphML, GraphExportOptions{
+		Seeds:    []string{"a"},
+		MinScore: 0.2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc graphML
+	if err = xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	// the weak a - d edge is filtered, d is still reached through c
+	if len(doc.Graph.Nodes) != 5 {
+		t.Errorf("expected 5 nodes, got %d", len(doc.Graph.Nodes))
+	}
+	if len(doc.Graph.Edges) != 4 {
+		t.Errorf("expected 4 edges, got %d", len(doc.Graph.Edges))
+	}
+}
@@ -0,0 +1,270 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Limit bounds the rate and the concurrency of calls. Zero values leave
+// the rate or the concurrency unbounded
+type Limit struct {
+	// Rate is the number of calls per second
+	Rate float64
+	// Burst is how many calls can be made at once after a quiet period,
+	// Rate rounded up if not set
+	Burst       int
+	MaxInFlight int
+}
+
+// RateLimits limit the calls made to one service. Service applies to
+// every call, Reads and Writes to the calls of their class on top of it.
+// Calls wait for their turn until their context is done
+type RateLimits struct {
+	Service Limit
+	Reads   Limit
+	Writes  Limit
+}
+
+type MethodClass int
+
+const (
+	MethodRead MethodClass = iota
+	MethodWrite
+)
+
+func (c MethodClass) String() string {
+	if c == MethodRead {
+		return "read"
+	}
+	return "write"
+}
+
+// readPrefixes are the prefixes of the names of methods that do not change
+// anything
+var readPrefixes = []string{"Get", "Count", "Is", "Are", "Recommend", "Suggest", "Mutual", "Test"}
+
+func methodClass(method string) MethodClass {
+	for _, p := range readPrefixes {
+		if strings.HasPrefix(method, p) {
+			return MethodRead
+		}
+	}
+	return MethodWrite
+}
+
+// ThrottleMetrics can be implemented by Metrics to receive how long calls
+// waited for the rate and concurrency limits. dropped is set for calls
+// whose context was done before they got their turn
+type ThrottleMetrics interface {
+	Throttled(service, method string, class MethodClass, wait time.Duration, dropped bool)
+}
+
+var ErrThrottled = errors.New("call throttled")
+
+// ThrottleError is returned when the context of a call is done while the
+// call waits for a limit. It reports the status of the context error and
+// does not count as a failure for circuit breakers
+type ThrottleError struct {
+	Method string
+	Err    error
+}
+
+func (e *ThrottleError) Error() string {
+	return e.Method + ": " + ErrThrottled.Error() + ": " + e.Err.Error()
+}
+
+func (e *ThrottleError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ThrottleError) Is(target error) bool {
+	return target == ErrThrottled
+}
+
+func (e *ThrottleError) GRPCStatus() *status.Status {
+	return status.FromContextError(e.Err)
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	now    func() time.Time
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = int(math.Ceil(rate))
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), now: time.Now}
+}
+
+// reserve takes a token and returns how long to wait until it is
+// available. Tokens may be taken ahead, driving the bucket below zero
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token whose call gave up waiting for it
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// limiter enforces one Limit
+type limiter struct {
+	bucket   *tokenBucket
+	inFlight chan struct{}
+}
+
+func newLimiter(l Limit) *limiter {
+	var res limiter
+	if l.Rate > 0 {
+		res.bucket = newTokenBucket(l.Rate, l.Burst)
+	}
+	if l.MaxInFlight > 0 {
+		res.inFlight = make(chan struct{}, l.MaxInFlight)
+	}
+	return &res
+}
+
+// acquire waits for a token and a free slot and reports whether it had to
+// wait. release must be called once the call ends if acquire succeeded
+func (l *limiter) acquire(ctx context.Context) (bool, error) {
+	waited := false
+	if l.bucket != nil {
+		if wait := l.bucket.reserve(); wait > 0 {
+			waited = true
+			t := time.NewTimer(wait)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				l.bucket.cancel()
+				return waited, ctx.Err()
+			}
+		}
+	}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+			return waited, nil
+		default:
+		}
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			if l.bucket != nil {
+				l.bucket.cancel()
+			}
+			return true, ctx.Err()
+		}
+		waited = true
+	}
+	return waited, nil
+}
+
+func (l *limiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// limits enforces the RateLimits of one connection
+type limits struct {
+	service *limiter
+	classes [2]*limiter
+	metrics ThrottleMetrics
+}
+
+func newLimits(l RateLimits, m Metrics) *limits {
+	res := &limits{
+		service: newLimiter(l.Service),
+		classes: [2]*limiter{MethodRead: newLimiter(l.Reads), MethodWrite: newLimiter(l.Writes)},
+	}
+	res.metrics, _ = m.(ThrottleMetrics)
+	return res
+}
+
+func (l *limits) acquire(ctx context.Context, method string) (func(), error) {
+	service, name := splitMethod(method)
+	class := methodClass(name)
+	start := time.Now()
+	dropped := func(err error) error {
+		if l.metrics != nil {
+			l.metrics.Throttled(service, name, class, time.Since(start), true)
+		}
+		return &ThrottleError{Method: method, Err: err}
+	}
+	// the class limit comes first so that calls waiting for it don't hold
+	// a service slot the other class could use
+	waitedClass, err := l.classes[class].acquire(ctx)
+	if err != nil {
+		return nil, dropped(err)
+	}
+	waitedService, err := l.service.acquire(ctx)
+	if err != nil {
+		l.classes[class].release()
+		return nil, dropped(err)
+	}
+	if l.metrics != nil && (waitedService || waitedClass) {
+		l.metrics.Throttled(service, name, class, time.Since(start), false)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.service.release()
+			l.classes[class].release()
+		})
+	}, nil
+}
+
+func (l *limits) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(l.unary),
+		grpc.WithChainStreamInterceptor(l.stream),
+	}
+}
+
+func (l *limits) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	release, err := l.acquire(ctx, method)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (l *limits) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	release, err := l.acquire(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return newFinishedStream(ctx, s, desc, func(error) { release() }), nil
+}
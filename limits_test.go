@@ -0,0 +1,156 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodClass(t *testing.T) {
+	for method, want := range map[string]MethodClass{
+		"GetComments":        MethodRead,
+		"RecommendLandmarks": MethodRead,
+		"IsBlocked":          MethodRead,
+		"SetLandmarkScore":   MethodWrite,
+		"AddLandmarkTag":     MethodWrite,
+		"ViewLandmark":       MethodWrite,
+	} {
+		if got := methodClass(method); got != want {
+			t.Errorf("%s: expected %s, got %s", method, want, got)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(10, 2)
+	b.now = func() time.Time { return now }
+	for i, want := range []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if got := b.reserve(); got != want {
+			t.Errorf("reservation %d: expected %s, got %s", i, want, got)
+		}
+	}
+	b.cancel()
+	b.cancel()
+	now = now.Add(time.Second)
+	if got := b.reserve(); got != 0 {
+		t.Errorf("expected the bucket to refill, got %s", got)
+	}
+}
+
+type throttleRecorder struct {
+	mu      sync.Mutex
+	class   MethodClass
+	waits   int
+	dropped int
+}
+
+func (r *throttleRecorder) Throttled(service, method string, class MethodClass, wait time.Duration, dropped bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.class = class
+	r.waits++
+	if dropped {
+		r.dropped++
+	}
+}
+
+func TestLimitsInFlight(t *testing.T) {
+	m := &struct {
+		recordingMetrics
+		throttleRecorder
+	}{}
+	l := newLimits(RateLimits{Service: Limit{MaxInFlight: 2}, Writes: Limit{MaxInFlight: 1}}, m)
+	entered := make(chan struct{})
+	unblock := make(chan struct{})
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		entered <- struct{}{}
+		<-unblock
+		return nil
+	}
+	const write = "/storage.StorageService/SetLandmarkScore"
+	done := make(chan error)
+	go func() { done <- l.unary(context.Background(), write, nil, nil, nil, invoker) }()
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	throttled := make(chan error)
+	go func() { throttled <- l.unary(ctx, write, nil, nil, nil, invoker) }()
+	time.Sleep(10 * time.Millisecond)
+
+	// reads are not limited, and the waiting write holds no service slot
+	read := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+	readCtx, cancelRead := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelRead()
+	if err := l.unary(readCtx, "/storage.StorageService/GetLandmark", nil, nil, nil, read); err != nil {
+		t.Fatal(err)
+	}
+
+	err := <-throttled
+	if !errors.Is(err, ErrThrottled) || status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected the second write to be throttled, got %v", err)
+	}
+	m.throttleRecorder.mu.Lock()
+	if m.dropped != 1 || m.class != MethodWrite {
+		t.Errorf("expected one dropped write, got %d %s", m.dropped, m.class)
+	}
+	m.throttleRecorder.mu.Unlock()
+
+	go func() { done <- l.unary(context.Background(), write, nil, nil, nil, invoker) }()
+	unblock <- struct{}{}
+	<-entered
+	unblock <- struct{}{}
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLimiterReturnsToken(t *testing.T) {
+	l := newLimiter(Limit{Rate: 1, Burst: 2, MaxInFlight: 1})
+	if _, err := l.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.acquire(ctx); err == nil {
+		t.Fatal("expected the second call to wait for a slot")
+	}
+	l.release()
+	if wait := l.bucket.reserve(); wait != 0 {
+		t.Errorf("expected the token of the canceled call back, got a wait of %s", wait)
+	}
+}
+
+func TestLimitsRate(t *testing.T) {
+	m := &struct {
+		recordingMetrics
+		throttleRecorder
+	}{}
+	l := newLimits(RateLimits{Service: Limit{Rate: 10, Burst: 1}}, m)
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := l.unary(context.Background(), "/feed.LandmarkFeed/GetFeed", nil, nil, nil, invoker); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected the second call to wait for a token, took %s", elapsed)
+	}
+	if m.waits != 1 || m.class != MethodRead {
+		t.Errorf("expected one throttled read, got %d %s", m.waits, m.class)
+	}
+}
@@ -0,0 +1,170 @@
+package lrpc
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LogOptions sets the levels calls are logged at. Without LogOptions
+// successful calls are logged at debug and failed ones at error
+type LogOptions struct {
+	SuccessLevel slog.Level
+	FailureLevel slog.Level
+}
+
+func (o *LogOptions) withDefaults() LogOptions {
+	if o == nil {
+		return LogOptions{SuccessLevel: slog.LevelDebug, FailureLevel: slog.LevelError}
+	}
+	return *o
+}
+
+// callLogger logs every call made on a connection with its method,
+// duration, status code, retry attempt and a summary of the request that
+// keeps only ids
+type callLogger struct {
+	logger *slog.Logger
+	opts   LogOptions
+}
+
+func newCallLogger(logger *slog.Logger, opts *LogOptions) *callLogger {
+	return &callLogger{logger: logger, opts: opts.withDefaults()}
+}
+
+func (l *callLogger) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(l.unary),
+		grpc.WithChainStreamInterceptor(l.stream),
+	}
+}
+
+func (l *callLogger) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	l.log(ctx, method, req, time.Since(start), retryAttempt(header, trailer), err)
+	return err
+}
+
+func (l *callLogger) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	start := time.Now()
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		l.log(ctx, method, nil, time.Since(start), 0, err)
+		return nil, err
+	}
+	return newFinishedStream(ctx, s, desc, func(err error) {
+		header, _ := s.Header()
+		l.log(ctx, method, nil, time.Since(start), retryAttempt(header, s.Trailer()), err)
+	}), nil
+}
+
+func (l *callLogger) log(ctx context.Context, method string, req any, d time.Duration, attempt int, err error) {
+	level := l.opts.SuccessLevel
+	msg := "rpc succeeded"
+	if err != nil {
+		level = l.opts.FailureLevel
+		msg = "rpc failed"
+	}
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	service, name := splitMethod(method)
+	attrs := []slog.Attr{
+		slog.String("service", service),
+		slog.String("method", name),
+		slog.Duration("duration", d),
+		slog.String("code", status.Code(err).String()),
+		slog.Int("attempt", attempt),
+	}
+	if id, ok := RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if m, ok := req.(proto.Message); ok {
+		if summary := requestSummary(m.ProtoReflect()); len(summary) > 0 {
+			attrs = append(attrs, slog.Attr{Key: "request", Value: slog.GroupValue(summary...)})
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// splitMethod splits "/package.Service/Method" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+// retryAttempt reads how many attempts preceded the one that produced the
+// response, 0 when the call was not retried
+func retryAttempt(mds ...metadata.MD) int {
+	for _, md := range mds {
+		if v := md.Get("grpc-previous-rpc-attempts"); len(v) > 0 {
+			n, _ := strconv.Atoi(v[0])
+			return n
+		}
+	}
+	return 0
+}
+
+// requestIdFields are the request fields that hold ids and are logged,
+// lists of ids are logged as their length
+var requestIdFields = map[string]bool{
+	"id":          true,
+	"id1":         true,
+	"id2":         true,
+	"userId":      true,
+	"user1":       true,
+	"user2":       true,
+	"sender":      true,
+	"receiver":    true,
+	"friendId":    true,
+	"blockedId":   true,
+	"requestId":   true,
+	"authorId":    true,
+	"editorId":    true,
+	"uploaderId":  true,
+	"landmarkId":  true,
+	"parentId":    true,
+	"commentId":   true,
+	"replyId":     true,
+	"tagId":       true,
+	"ids":         true,
+	"landmarkIds": true,
+}
+
+// requestSummary keeps the id fields of a request listed in
+// requestIdFields, so that logs never contain comment text or other user
+// content
+func requestSummary(m protoreflect.Message) []slog.Attr {
+	var attrs []slog.Attr
+	// fields are walked in declaration order, Range has no stable order
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		if fd.Kind() != protoreflect.StringKind || fd.IsMap() || !requestIdFields[name] || !m.Has(fd) {
+			continue
+		}
+		if fd.IsList() {
+			attrs = append(attrs, slog.Int(name, m.Get(fd).List().Len()))
+		} else {
+			attrs = append(attrs, slog.String(name, m.Get(fd).String()))
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,98 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCallLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	l := newCallLogger(logger, &LogOptions{SuccessLevel: slog.LevelInfo, FailureLevel: slog.LevelWarn})
+	req := &storage.CreateCommentRequest{
+		ParentId:    "landmark",
+		AuthorId:    "user",
+		Text:        "secret text",
+		Attachments: []string{"a"},
+	}
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok {
+				*h.HeaderAddr = metadata.Pairs("grpc-previous-rpc-attempts", "2")
+			}
+		}
+		return status.Error(codes.Unavailable, "storage is down")
+	}
+	err := l.unary(context.Background(), "/storage.StorageService/CreateComment", req, &storage.CreateCommentResponse{}, nil, invoker)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected the invoker error, got %v", err)
+	}
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("comment text was logged: %s", buf.String())
+	}
+
+	var entry struct {
+		Level    string
+		Msg      string
+		Service  string
+		Method   string
+		Code     string
+		Attempt  int
+		Error    string
+		Duration int64
+		Request  map[string]string
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Level != "WARN" || entry.Service != "storage.StorageService" || entry.Method != "CreateComment" ||
+		entry.Code != "Unavailable" || entry.Attempt != 2 || entry.Error != "storage is down" {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+	if want := map[string]string{"parentId": "landmark", "authorId": "user"}; len(entry.Request) != 2 ||
+		entry.Request["parentId"] != want["parentId"] || entry.Request["authorId"] != want["authorId"] {
+		t.Errorf("expected request %v, got %v", want, entry.Request)
+	}
+}
+
+func TestCallLoggerLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	l := newCallLogger(logger, nil)
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return nil
+	}
+	if err := l.unary(context.Background(), "/feed.LandmarkFeed/GetFeed", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("successful call was logged above debug: %s", buf.String())
+	}
+}
+
+func TestRequestSummary(t *testing.T) {
+	for _, tc := range []struct {
+		req  proto.Message
+		want string
+	}{
+		{&storage.SendFriendRequestRequest{Sender: "a", Receiver: "b"}, "[sender=a receiver=b]"},
+		{&storage.IsFriendRequest{User1: "a", User2: "b"}, "[user1=a user2=b]"},
+		{&storage.CreateCommentRequest{ParentId: "l", AuthorId: "a", Text: "secret"}, "[parentId=l authorId=a]"},
+	} {
+		if got := fmt.Sprint(requestSummary(tc.req.ProtoReflect())); got != tc.want {
+			t.Errorf("%T: expected %s, got %s", tc.req, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Package lrpcprom exports the metrics of an lrpc client to Prometheus
+package lrpcprom
+
+import (
+	"time"
+
+	"github.com/emalak/lrpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Collector implements lrpc.Metrics, pass it as Settings.Metrics and
+// register it with a prometheus.Registerer
+type Collector struct {
+	calls       *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	inFlight    *prometheus.GaugeVec
+	retries     *prometheus.CounterVec
+	transitions *prometheus.CounterVec
+	connState   *prometheus.GaugeVec
+
+	breakerTransitions *prometheus.CounterVec
+	breakerState       *prometheus.GaugeVec
+
+	throttleWait    *prometheus.HistogramVec
+	throttleDropped *prometheus.CounterVec
+}
+
+var _ lrpc.Metrics = (*Collector)(nil)
+var _ lrpc.BreakerMetrics = (*Collector)(nil)
+var _ lrpc.ThrottleMetrics = (*Collector)(nil)
+var _ prometheus.Collector = (*Collector)(nil)
+
+// New creates a collector whose metrics are prefixed with namespace, e.g.
+// <namespace>_lrpc_calls_total
+func New(namespace string) *Collector {
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "calls_total",
+			Help:      "Calls made by the client, by status code.",
+		}, []string{"service", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "call_duration_seconds",
+			Help:      "Duration of calls made by the client.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "calls_in_flight",
+			Help:      "Calls that have started and not finished yet.",
+		}, []string{"service", "method"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "retries_total",
+			Help:      "Attempts that were retried by gRPC.",
+		}, []string{"service", "method"}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "conn_state_transitions_total",
+			Help:      "Connection state transitions.",
+		}, []string{"service", "from", "to"}),
+		connState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "conn_state",
+			Help:      "1 for the state the connection is in, 0 for the others.",
+		}, []string{"service", "state"}),
+
+		breakerTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "breaker_state_transitions_total",
+			Help:      "Circuit breaker state transitions.",
+		}, []string{"breaker", "from", "to"}),
+		breakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "breaker_state",
+			Help:      "1 for the state the circuit breaker is in, 0 for the others.",
+		}, []string{"breaker", "state"}),
+
+		throttleWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "throttle_wait_seconds",
+			Help:      "Time calls waited for rate and concurrency limits.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method", "class"}),
+		throttleDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "lrpc",
+			Name:      "throttle_dropped_total",
+			Help:      "Calls whose context was done while they waited for limits.",
+		}, []string{"service", "method", "class"}),
+	}
+}
+
+func (c *Collector) CallStarted(service, method string) {
+	c.inFlight.WithLabelValues(service, method).Inc()
+}
+
+func (c *Collector) CallFinished(service, method string, code codes.Code, d time.Duration, retries int) {
+	c.inFlight.WithLabelValues(service, method).Dec()
+	c.calls.WithLabelValues(service, method, code.String()).Inc()
+	c.duration.WithLabelValues(service, method).Observe(d.Seconds())
+	if retries > 0 {
+		c.retries.WithLabelValues(service, method).Add(float64(retries))
+	}
+}
+
+func (c *Collector) ConnStateChanged(service string, from, to connectivity.State) {
+	if from != to {
+		c.transitions.WithLabelValues(service, from.String(), to.String()).Inc()
+		c.connState.WithLabelValues(service, from.String()).Set(0)
+	}
+	c.connState.WithLabelValues(service, to.String()).Set(1)
+}
+
+func (c *Collector) BreakerStateChanged(name string, from, to lrpc.BreakerState) {
+	c.breakerTransitions.WithLabelValues(name, from.String(), to.String()).Inc()
+	c.breakerState.WithLabelValues(name, from.String()).Set(0)
+	c.breakerState.WithLabelValues(name, to.String()).Set(1)
+}
+
+func (c *Collector) Throttled(service, method string, class lrpc.MethodClass, wait time.Duration, dropped bool) {
+	c.throttleWait.WithLabelValues(service, method, class.String()).Observe(wait.Seconds())
+	if dropped {
+		c.throttleDropped.WithLabelValues(service, method, class.String()).Inc()
+	}
+}
+
+func (c *Collector) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		c.calls, c.duration, c.inFlight, c.retries, c.transitions, c.connState,
+		c.breakerTransitions, c.breakerState, c.throttleWait, c.throttleDropped,
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, v := range c.collectors() {
+		v.Describe(ch)
+	}
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, v := range c.collectors() {
+		v.Collect(ch)
+	}
+}
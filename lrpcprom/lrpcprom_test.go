@@ -0,0 +1,70 @@
+package lrpcprom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emalak/lrpc"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestCollector(t *testing.T) {
+	c := New("app")
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c)
+
+	c.CallStarted("storage.StorageService", "GetComments")
+	c.CallStarted("storage.StorageService", "GetComments")
+	c.CallFinished("storage.StorageService", "GetComments", codes.OK, 20*time.Millisecond, 2)
+	c.ConnStateChanged("feed.LandmarkFeed", connectivity.Ready, connectivity.Ready)
+	c.ConnStateChanged("feed.LandmarkFeed", connectivity.Ready, connectivity.TransientFailure)
+	c.ConnStateChanged("storage.StorageService", connectivity.Ready, connectivity.Ready)
+	c.BreakerStateChanged("feed.LandmarkFeed", lrpc.BreakerClosed, lrpc.BreakerOpen)
+	c.Throttled("storage.StorageService", "SetLandmarkScore", lrpc.MethodWrite, time.Second, true)
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]float64{}
+	for _, f := range families {
+		for _, m := range f.Metric {
+			key := f.GetName()
+			for _, l := range m.Label {
+				key += " " + l.GetValue()
+			}
+			values[key] = value(m)
+		}
+	}
+	for key, want := range map[string]float64{
+		"app_lrpc_calls_total OK GetComments storage.StorageService":                      1,
+		"app_lrpc_calls_in_flight GetComments storage.StorageService":                     1,
+		"app_lrpc_retries_total GetComments storage.StorageService":                       2,
+		"app_lrpc_call_duration_seconds GetComments storage.StorageService":               1,
+		"app_lrpc_conn_state_transitions_total READY feed.LandmarkFeed TRANSIENT_FAILURE": 1,
+		"app_lrpc_conn_state feed.LandmarkFeed TRANSIENT_FAILURE":                         1,
+		"app_lrpc_conn_state feed.LandmarkFeed READY":                                     0,
+		"app_lrpc_throttle_dropped_total write SetLandmarkScore storage.StorageService":   1,
+	} {
+		if got, ok := values[key]; !ok || got != want {
+			t.Errorf("%s: expected %v, got %v (present %v)", key, want, got, ok)
+		}
+	}
+}
+
+// value returns the value of a counter or gauge, or the sample count of a
+// histogram
+func value(m *dto.Metric) float64 {
+	switch {
+	case m.Counter != nil:
+		return m.Counter.GetValue()
+	case m.Gauge != nil:
+		return m.Gauge.GetValue()
+	case m.Histogram != nil:
+		return float64(m.Histogram.GetSampleCount())
+	}
+	return 0
+}
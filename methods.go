@@ -2,9 +2,14 @@ package lrpc
 
 import (
 	"context"
+	"fmt"
 	feed "github.com/emalak/lrpc/rpc/feed"
 	storage "github.com/emalak/lrpc/rpc/storage"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"time"
 )
 
 type gateway interface {
@@ -50,6 +55,30 @@ func (c *Client) AddLandmark(ctx context.Context, id string, score float32) erro
 	return err
 }
 
+// CreateLandmarks adds the landmarks with their coordinates and attaches
+// their tags with DefaultLandmarkTagScore. It stops at the first failure
+func (c *Client) CreateLandmarks(ctx context.Context, specs []LandmarkSpec) error {
+	for _, spec := range specs {
+		_, err := c.Storage.Client.AddLandmark(ctx, &storage.AddLandmarkRequest{
+			Id:          spec.Id,
+			Score:       float32(spec.Score),
+			Latitude:    float32(spec.Coordinates.Latitude),
+			Longitude:   float32(spec.Coordinates.Longitude),
+			Latitude64:  proto.Float64(spec.Coordinates.Latitude),
+			Longitude64: proto.Float64(spec.Coordinates.Longitude),
+		})
+		if err != nil {
+			return fmt.Errorf("landmark %s: %w", spec.Id, err)
+		}
+		for _, tag := range spec.Tags {
+			if err = c.AddLandmarkTag(ctx, spec.Id, tag, DefaultLandmarkTagScore); err != nil {
+				return fmt.Errorf("landmark %s: tag %s: %w", spec.Id, tag, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (c *Client) LikeLandmark(ctx context.Context, userId, landmarkId string) error {
 	_, err := c.Storage.Client.LikeLandmark(ctx, &storage.LikeLandmarkRequest{
 		UserId:     userId,
@@ -84,10 +113,12 @@ func (c *Client) ViewLandmark(ctx context.Context, userId, landmarkId string) er
 
 func (c *Client) RecommendLandmarks(ctx context.Context, userId string, latitude, longitude float64, amount int) ([]string, error) {
 	res, err := c.Storage.Client.RecommendLandmarks(ctx, &storage.RecommendLandmarksRequest{
-		UserId:    userId,
-		Amount:    int64(amount),
-		Latitude:  float32(latitude),
-		Longitude: float32(longitude),
+		UserId:      userId,
+		Amount:      int64(amount),
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		Latitude64:  proto.Float64(latitude),
+		Longitude64: proto.Float64(longitude),
 	})
 	if err != nil {
 		return nil, err
@@ -108,23 +139,88 @@ func (c *Client) AddUser(ctx context.Context, userId string) error {
 	return err
 }
 
-func (c *Client) CreateComment(ctx context.Context, parentId, authorId, text string, attachments []string, rating int) error {
-	_, err := c.Storage.Client.CreateComment(ctx, &storage.CreateCommentRequest{
+func (c *Client) CreateComment(ctx context.Context, parentId, authorId, text string, attachments []string, rating int) (*Comment, error) {
+	return c.createComment(ctx, &storage.CreateCommentRequest{
 		ParentId:    parentId,
 		AuthorId:    authorId,
 		Text:        text,
 		Attachments: attachments,
 		Rating:      int32(rating),
 	})
-	return err
+}
+
+// ReplyToComment posts a reply to a comment, the reply belongs to the same
+// landmark as the comment it replies to
+func (c *Client) ReplyToComment(ctx context.Context, commentId, authorId, text string, attachments []string) (*Comment, error) {
+	return c.createComment(ctx, &storage.CreateCommentRequest{
+		ReplyId:     commentId,
+		AuthorId:    authorId,
+		Text:        text,
+		Attachments: attachments,
+	})
+}
+
+func (c *Client) createComment(ctx context.Context, req *storage.CreateCommentRequest) (*Comment, error) {
+	comment := &Comment{
+		ParentId:    req.ParentId,
+		UserId:      req.AuthorId,
+		Grade:       int(req.Rating),
+		Attachments: req.Attachments,
+		Text:        req.Text,
+		ReplyId:     req.ReplyId,
+	}
+	flags, err := c.moderate(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+	req.Text = comment.Text
+	if c.attachments.VerifyOnComment && len(req.Attachments) > 0 {
+		if err := c.VerifyAttachments(ctx, req.Attachments); err != nil {
+			return nil, err
+		}
+	}
+	res, err := c.Storage.Client.CreateComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	comment.Id = res.Id
+	comment.Timestamp = int(res.Timestamp)
+	c.reportFlagged(ctx, comment, flags)
+	return comment, nil
 }
 
 func (c *Client) GetComments(ctx context.Context, landmarkId string, limit, offset int) ([]*Comment, error) {
-	res, err := c.Storage.Client.GetComments(ctx, &storage.GetCommentsRequest{
+	return c.getComments(ctx, &storage.GetCommentsRequest{
 		LandmarkId: landmarkId,
 		Limit:      int32(limit),
 		Offset:     int32(offset),
 	})
+}
+
+// GetCommentsForUser is GetComments with UserReaction set to the given
+// user's reaction on every comment
+func (c *Client) GetCommentsForUser(ctx context.Context, landmarkId, userId string, limit, offset int) ([]*Comment, error) {
+	return c.getComments(ctx, &storage.GetCommentsRequest{
+		LandmarkId: landmarkId,
+		UserId:     userId,
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+	})
+}
+
+// GetCommentsWithDeleted is GetComments including soft deleted comments,
+// which have Deleted set
+func (c *Client) GetCommentsWithDeleted(ctx context.Context, landmarkId string, limit, offset int) ([]*Comment, error) {
+	return c.getComments(ctx, &storage.GetCommentsRequest{
+		LandmarkId:     landmarkId,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+		IncludeDeleted: true,
+	})
+}
+
+func (c *Client) getComments(ctx context.Context, req *storage.GetCommentsRequest) ([]*Comment, error) {
+	res, err := c.Storage.Client.GetComments(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -133,17 +229,7 @@ func (c *Client) GetComments(ctx context.Context, landmarkId string, limit, offs
 	}
 	comments := make([]*Comment, len(res.Comments))
 	for i, v := range res.Comments {
-		comment := &Comment{
-			Id:          v.Id,
-			ParentId:    v.ParentId,
-			UserId:      v.UserId,
-			Grade:       int(v.Grade),
-			Attachments: v.Attachments,
-			Text:        v.Text,
-			ReplyId:     v.ReplyId,
-			Timestamp:   int(v.Timestamp),
-		}
-		comments[i] = comment
+		comments[i] = commentFromStorage(v)
 	}
 	return comments, nil
 }
@@ -162,27 +248,19 @@ func (c *Client) GetProfileComments(ctx context.Context, userId string, limit, o
 	}
 	comments := make([]*Comment, len(res.Comments))
 	for i, v := range res.Comments {
-		comment := &Comment{
-			ParentId:    v.ParentId,
-			Id:          v.Id,
-			UserId:      v.UserId,
-			Grade:       int(v.Grade),
-			Attachments: v.Attachments,
-			Text:        v.Text,
-			ReplyId:     v.ReplyId,
-			Timestamp:   int(v.Timestamp),
-		}
-		comments[i] = comment
+		comments[i] = commentFromStorage(v)
 	}
 	return comments, nil
 }
 
 func (c *Client) GetFeed(ctx context.Context, userId string, latitude, longitude float64, amount int) ([]string, error) {
 	res, err := c.Feed.Client.GetFeed(ctx, &feed.GetFeedRequest{
-		UserId:    userId,
-		Amount:    int32(amount),
-		Latitude:  float32(latitude),
-		Longitude: float32(longitude),
+		UserId:      userId,
+		Amount:      int32(amount),
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		Latitude64:  proto.Float64(latitude),
+		Longitude64: proto.Float64(longitude),
 	})
 	if err != nil {
 		return nil, err
@@ -192,17 +270,11 @@ func (c *Client) GetFeed(ctx context.Context, userId string, latitude, longitude
 
 func (c *Client) GetFavouriteLandmarks(ctx context.Context, userId string, limit, offset int, northEast, southWest Coordinates) ([]uuid.UUID, error) {
 	res, err := c.Storage.Client.GetFavouriteLandmarks(ctx, &storage.GetFavouriteLandmarksRequest{
-		UserId: userId,
-		Limit:  int32(limit),
-		Offset: int32(offset),
-		Northeast: &storage.Coordinates{
-			Longitude: float32(northEast.Longitude),
-			Latitude:  float32(northEast.Latitude),
-		},
-		Southeast: &storage.Coordinates{
-			Longitude: float32(southWest.Longitude),
-			Latitude:  float32(southWest.Latitude),
-		},
+		UserId:    userId,
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+		Northeast: storageCoordinates(northEast),
+		Southeast: storageCoordinates(southWest),
 	})
 	if err != nil {
 		return nil, err
@@ -234,6 +306,16 @@ func (c *Client) GetUserTags(ctx context.Context, userId string) ([]string, erro
 	return res.Ids, nil
 }
 
+// GetUserTagsVersion returns the user's tags along with their version for
+// use with ChangeUserTagsIfVersion
+func (c *Client) GetUserTagsVersion(ctx context.Context, userId string) ([]string, int64, error) {
+	res, err := c.Storage.Client.GetUserTags(ctx, &storage.GetUserTagsRequest{UserId: userId})
+	if err != nil {
+		return nil, 0, err
+	}
+	return res.Ids, res.Version, nil
+}
+
 func (c *Client) GetLandmarkTags(ctx context.Context, landmarkId string) ([]string, error) {
 	res, err := c.Storage.Client.GetLandmarkTags(ctx, &storage.GetLandmarkTagsRequest{LandmarkId: landmarkId})
 	if err != nil {
@@ -339,14 +421,102 @@ func (c *Client) DeleteComment(ctx context.Context, userId, commentId string) er
 }
 
 func (c *Client) EditComment(ctx context.Context, userId, commentId, text string) error {
-	_, err := c.Storage.Client.EditComment(ctx, &storage.EditCommentRequest{
+	comment := &Comment{Id: commentId, UserId: userId, Text: text}
+	flags, err := c.moderate(ctx, comment)
+	if err != nil {
+		return err
+	}
+	_, err = c.Storage.Client.EditComment(ctx, &storage.EditCommentRequest{
+		UserId:    userId,
+		CommentId: commentId,
+		Text:      comment.Text,
+	})
+	if err != nil {
+		return err
+	}
+	c.reportFlagged(ctx, comment, flags)
+	return nil
+}
+
+// SoftDeleteComment hides a comment from GetComments while keeping it and
+// its history for RestoreComment and moderation. DeleteComment removes it
+// for good
+func (c *Client) SoftDeleteComment(ctx context.Context, userId, commentId string) error {
+	_, err := c.Storage.Client.SoftDeleteComment(ctx, &storage.SoftDeleteCommentRequest{
+		UserId:    userId,
+		CommentId: commentId,
+	})
+	return err
+}
+
+func (c *Client) RestoreComment(ctx context.Context, userId, commentId string) error {
+	_, err := c.Storage.Client.RestoreComment(ctx, &storage.RestoreCommentRequest{
+		UserId:    userId,
+		CommentId: commentId,
+	})
+	return err
+}
+
+// ReactToComment sets the user's reaction on a comment, replacing any
+// previous one
+func (c *Client) ReactToComment(ctx context.Context, userId, commentId string, kind ReactionKind) error {
+	_, err := c.Storage.Client.ReactToComment(ctx, &storage.ReactToCommentRequest{
 		UserId:    userId,
 		CommentId: commentId,
-		Text:      text,
+		Kind:      storage.ReactionKind(kind),
 	})
 	return err
 }
 
+func (c *Client) UnreactToComment(ctx context.Context, userId, commentId string) error {
+	_, err := c.Storage.Client.UnreactToComment(ctx, &storage.UnreactToCommentRequest{
+		UserId:    userId,
+		CommentId: commentId,
+	})
+	return err
+}
+
+func (c *Client) GetCommentReactions(ctx context.Context, commentId string, limit, offset int) ([]CommentReaction, error) {
+	res, err := c.Storage.Client.GetCommentReactions(ctx, &storage.GetCommentReactionsRequest{
+		CommentId: commentId,
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Reactions) == 0 {
+		return nil, nil
+	}
+	reactions := make([]CommentReaction, len(res.Reactions))
+	for i, v := range res.Reactions {
+		reactions[i] = CommentReaction{
+			UserId:    v.UserId,
+			Kind:      ReactionKind(v.Kind),
+			Timestamp: int(v.Timestamp),
+		}
+	}
+	return reactions, nil
+}
+
+// GetCommentHistory returns the revisions of a comment, oldest first. The
+// last revision is the current text
+func (c *Client) GetCommentHistory(ctx context.Context, commentId string) ([]CommentRevision, error) {
+	res, err := c.Storage.Client.GetCommentHistory(ctx, &storage.GetCommentHistoryRequest{CommentId: commentId})
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]CommentRevision, len(res.Revisions))
+	for i, v := range res.Revisions {
+		revisions[i] = CommentRevision{
+			Text:      v.Text,
+			Timestamp: int(v.Timestamp),
+			EditorId:  v.EditorId,
+		}
+	}
+	return revisions, nil
+}
+
 func (c *Client) GetFriends(ctx context.Context, userId string) ([]string, error) {
 	res, err := c.Storage.Client.GetFriends(ctx, &storage.GetFriendsRequest{UserId: userId})
 	if err != nil {
@@ -355,6 +525,8 @@ func (c *Client) GetFriends(ctx context.Context, userId string) ([]string, error
 	return res.Ids, err
 }
 
+// AddFriend creates the friendship immediately, without the receiver's
+// consent. Use SendFriendRequest for user initiated friendships
 func (c *Client) AddFriend(ctx context.Context, sender, receiver string) error {
 	_, err := c.Storage.Client.AddFriend(ctx, &storage.AddFriendRequest{Sender: sender, Receiver: receiver})
 	return err
@@ -381,17 +553,11 @@ func (c *Client) IsFriend(ctx context.Context, user1, user2 string) (bool, error
 
 func (c *Client) GetLandmarksByTag(ctx context.Context, northEast, southWest Coordinates, tagId string, limit, offset int) ([]string, error) {
 	res, err := c.Storage.Client.GetLandmarksByTag(ctx, &storage.GetLandmarksByTagRequest{
-		TagId: tagId,
-		Northeast: &storage.Coordinates{
-			Longitude: float32(northEast.Longitude),
-			Latitude:  float32(northEast.Latitude),
-		},
-		Southeast: &storage.Coordinates{
-			Longitude: float32(southWest.Longitude),
-			Latitude:  float32(southWest.Latitude),
-		},
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		TagId:     tagId,
+		Northeast: storageCoordinates(northEast),
+		Southeast: storageCoordinates(southWest),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
 	})
 	if err != nil {
 		return nil, err
@@ -401,18 +567,12 @@ func (c *Client) GetLandmarksByTag(ctx context.Context, northEast, southWest Coo
 
 func (c *Client) GetLandmarksFiltered(ctx context.Context, include, exclude []string, limit, offset int, northEast, southWest Coordinates) ([]string, error) {
 	res, err := c.Storage.Client.GetLandmarksFiltered(ctx, &storage.GetLandmarksFilteredRequest{
-		Include: include,
-		Exclude: exclude,
-		Offset:  int32(offset),
-		Limit:   int32(limit),
-		Northeast: &storage.Coordinates{
-			Longitude: float32(northEast.Longitude),
-			Latitude:  float32(northEast.Latitude),
-		},
-		Southeast: &storage.Coordinates{
-			Longitude: float32(southWest.Longitude),
-			Latitude:  float32(southWest.Latitude),
-		},
+		Include:   include,
+		Exclude:   exclude,
+		Offset:    int32(offset),
+		Limit:     int32(limit),
+		Northeast: storageCoordinates(northEast),
+		Southeast: storageCoordinates(southWest),
 	})
 	if err != nil {
 		return nil, err
@@ -420,7 +580,7 @@ func (c *Client) GetLandmarksFiltered(ctx context.Context, include, exclude []st
 	return res.Ids, nil
 }
 
-func (c *Client) GetRecentFriendsFavourites(ctx context.Context, userId string, limit, offset int) ([]*storage.FriendLikedLandmark, error) {
+func (c *Client) GetRecentFriendsFavourites(ctx context.Context, userId string, limit, offset int) ([]*FriendFavourite, error) {
 	res, err := c.Storage.Client.GetRecentFriendsFavourites(ctx, &storage.GetRecentFriendsFavouritesRequest{
 		UserId: userId,
 		Limit:  int32(limit),
@@ -429,7 +589,26 @@ func (c *Client) GetRecentFriendsFavourites(ctx context.Context, userId string,
 	if err != nil {
 		return nil, err
 	}
-	return res.Result, err
+	if len(res.Result) == 0 {
+		return nil, nil
+	}
+	favourites := make([]*FriendFavourite, len(res.Result))
+	for i, v := range res.Result {
+		friendId, err := uuid.Parse(v.FriendId)
+		if err != nil {
+			return nil, err
+		}
+		landmarkId, err := uuid.Parse(v.LandmarkId)
+		if err != nil {
+			return nil, err
+		}
+		favourites[i] = &FriendFavourite{
+			FriendId:   friendId,
+			LandmarkId: landmarkId,
+			LikedAt:    time.Unix(v.Timestamp, 0),
+		}
+	}
+	return favourites, nil
 }
 
 func (c *Client) IsReviewedBy(ctx context.Context, landmarkId, userId string) (bool, error) {
@@ -451,17 +630,36 @@ func (c *Client) GetReview(ctx context.Context, landmarkId, userId string) (*Com
 	if res.Review == nil {
 		return nil, nil
 	}
-	com := &Comment{
-		Id:          res.Review.Id,
-		ParentId:    res.Review.ParentId,
-		UserId:      res.Review.UserId,
-		Grade:       int(res.Review.Grade),
-		Attachments: res.Review.Attachments,
-		Text:        res.Review.Text,
-		ReplyId:     res.Review.ReplyId,
-		Timestamp:   int(res.Review.Timestamp),
+	return commentFromStorage(res.Review), nil
+}
+
+func (c *Client) GetRatingSummary(ctx context.Context, landmarkId string) (*RatingSummary, error) {
+	res, err := c.Storage.Client.GetRatingSummary(ctx, &storage.GetRatingSummaryRequest{LandmarkId: landmarkId})
+	if err != nil {
+		return nil, err
+	}
+	if res.Summary == nil {
+		return &RatingSummary{LandmarkId: landmarkId}, nil
+	}
+	return ratingSummaryFromStorage(res.Summary), nil
+}
+
+// GetRatingSummaries returns rating summaries by landmark id in a single
+// call, e.g. for feed cards. Landmarks without reviews map to an empty
+// summary
+func (c *Client) GetRatingSummaries(ctx context.Context, landmarkIds []string) (map[string]*RatingSummary, error) {
+	res, err := c.Storage.Client.GetRatingSummaries(ctx, &storage.GetRatingSummariesRequest{LandmarkIds: landmarkIds})
+	if err != nil {
+		return nil, err
 	}
-	return com, nil
+	summaries := make(map[string]*RatingSummary, len(landmarkIds))
+	for _, id := range landmarkIds {
+		summaries[id] = &RatingSummary{LandmarkId: id}
+	}
+	for _, v := range res.Summaries {
+		summaries[v.LandmarkId] = ratingSummaryFromStorage(v)
+	}
+	return summaries, nil
 }
 
 func (c *Client) SetLandmarkScore(ctx context.Context, landmarkId string, score float64) error {
@@ -482,9 +680,11 @@ func (c *Client) NotInterested(ctx context.Context, userId, landmarkId string) e
 
 func (c *Client) ResetFeed(ctx context.Context, userId string, latitude, longitude float64) error {
 	_, err := c.Feed.Client.ResetFeed(ctx, &feed.ResetFeedRequest{
-		UserId:    userId,
-		Latitude:  float32(latitude),
-		Longitude: float32(longitude),
+		UserId:      userId,
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		Latitude64:  proto.Float64(latitude),
+		Longitude64: proto.Float64(longitude),
 	})
 	return err
 }
@@ -502,23 +702,40 @@ func (c *Client) ChangeUserTags(ctx context.Context, userId string, tags []strin
 	return err
 }
 
+// ChangeUserTagsIfVersion replaces the user's tags only if they are still
+// at the given version and returns the new version. A *UserTagsConflictError
+// is returned if the tags have changed since
+func (c *Client) ChangeUserTagsIfVersion(ctx context.Context, userId string, tags []string, version int64) (int64, error) {
+	res, err := c.Storage.Client.ChangeUserTags(ctx, &storage.ChangeUserTagsRequest{
+		UserId:          userId,
+		Tags:            tags,
+		ExpectedVersion: proto.Int64(version),
+	})
+	if status.Code(err) == codes.Aborted {
+		return 0, &UserTagsConflictError{UserId: userId, Version: version}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return res.Version, nil
+}
+
 func (c *Client) SetLandmarkCoords(ctx context.Context, landmarkId string, coords Coordinates) error {
 	_, err := c.Storage.Client.SetLandmarkCoords(ctx, &storage.SetLandmarkCoordsRequest{
 		LandmarkId: landmarkId,
-		Coords: &storage.Coordinates{
-			Longitude: float32(coords.Longitude),
-			Latitude:  float32(coords.Latitude),
-		},
+		Coords:     storageCoordinates(coords),
 	})
 	return err
 }
 
-func (c *Client) TestGetFeed(ctx context.Context, userId string, latitude, longitude float32, amount int) ([]string, error) {
+func (c *Client) TestGetFeed(ctx context.Context, userId string, latitude, longitude float64, amount int) ([]string, error) {
 	res, err := c.Storage.Client.TestGetRecommended(ctx, &storage.TestGetFeedRequest{
-		UserId:    userId,
-		Amount:    int32(amount),
-		Latitude:  latitude,
-		Longitude: longitude,
+		UserId:      userId,
+		Amount:      int32(amount),
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		Latitude64:  proto.Float64(latitude),
+		Longitude64: proto.Float64(longitude),
 	})
 	if err != nil {
 		return nil, err
@@ -562,21 +779,15 @@ func (c *Client) GetLandmarkTagsWithScore(ctx context.Context, id string) ([]Tag
 	return tags, nil
 }
 
-func (c *Client) GetActivity(ctx context.Context, activity string, include, exclude []string, northEast, southWest Coordinates, limit, offset int) ([]*LandmarkItem, error) {
+func (c *Client) GetActivity(ctx context.Context, activity string, include, exclude []string, northEast, southWest Coordinates, limit, offset int) (LandmarkItems, error) {
 	res, err := c.Storage.Client.GetActivity(ctx, &storage.GetActivityRequest{
-		Activity: activity,
-		Northeast: &storage.Coordinates{
-			Longitude: float32(northEast.Longitude),
-			Latitude:  float32(northEast.Latitude),
-		},
-		Southeast: &storage.Coordinates{
-			Longitude: float32(southWest.Longitude),
-			Latitude:  float32(southWest.Latitude),
-		},
-		Limit:   int32(limit),
-		Offset:  int32(offset),
-		Include: include,
-		Exclude: exclude,
+		Activity:  activity,
+		Northeast: storageCoordinates(northEast),
+		Southeast: storageCoordinates(southWest),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+		Include:   include,
+		Exclude:   exclude,
 	})
 	if err != nil {
 		return nil, err
@@ -584,13 +795,13 @@ func (c *Client) GetActivity(ctx context.Context, activity string, include, excl
 	if len(res.Items) == 0 {
 		return nil, nil
 	}
-	items := make([]*LandmarkItem, len(res.Items))
+	items := make(LandmarkItems, len(res.Items))
 	for i, v := range res.Items {
 		items[i] = &LandmarkItem{
 			Id:        v.Id,
 			Score:     float64(v.Score),
-			Latitude:  float64(v.Latitude),
-			Longitude: float64(v.Longitude),
+			Latitude:  preciseOr(v.Latitude64, v.Latitude),
+			Longitude: preciseOr(v.Longitude64, v.Longitude),
 			Tags:      v.Tags,
 		}
 	}
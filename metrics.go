@@ -0,0 +1,78 @@
+package lrpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics receives measurements of the calls made by the client and of
+// its connections. Services are the full gRPC service names, e.g.
+// storage.StorageService. Implementations must be safe for concurrent
+// use, see the lrpcprom package for a Prometheus one
+type Metrics interface {
+	CallStarted(service, method string)
+	// CallFinished reports a call that ended with code after d, retries
+	// is the number of attempts that preceded the last one
+	CallFinished(service, method string, code codes.Code, d time.Duration, retries int)
+	// ConnStateChanged reports a state transition of a connection. The
+	// state a connection is in once it is dialed is reported first, with
+	// from equal to to
+	ConnStateChanged(service string, from, to connectivity.State)
+}
+
+type metricsInterceptor struct {
+	m Metrics
+}
+
+func (i metricsInterceptor) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.unary),
+		grpc.WithChainStreamInterceptor(i.stream),
+	}
+}
+
+func (i metricsInterceptor) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	service, name := splitMethod(method)
+	var header, trailer metadata.MD
+	i.m.CallStarted(service, name)
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	i.m.CallFinished(service, name, status.Code(err), time.Since(start), retryAttempt(header, trailer))
+	return err
+}
+
+func (i metricsInterceptor) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	service, name := splitMethod(method)
+	i.m.CallStarted(service, name)
+	start := time.Now()
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		i.m.CallFinished(service, name, status.Code(err), time.Since(start), 0)
+		return nil, err
+	}
+	return newFinishedStream(ctx, s, desc, func(err error) {
+		header, _ := s.Header()
+		i.m.CallFinished(service, name, status.Code(err), time.Since(start), retryAttempt(header, s.Trailer()))
+	}), nil
+}
+
+// watchConnState reports the state of conn and its transitions until it
+// is closed
+func watchConnState(conn *grpc.ClientConn, service string, m Metrics) {
+	state := conn.GetState()
+	m.ConnStateChanged(service, state, state)
+	for state != connectivity.Shutdown {
+		if !conn.WaitForStateChange(context.Background(), state) {
+			return
+		}
+		next := conn.GetState()
+		m.ConnStateChanged(service, state, next)
+		state = next
+	}
+}
@@ -0,0 +1,67 @@
+package lrpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// recordingMetrics keeps every measurement it receives
+type recordingMetrics struct {
+	mu       sync.Mutex
+	started  []string
+	finished []string
+	codes    []codes.Code
+	retries  []int
+	states   []connectivity.State
+}
+
+func (m *recordingMetrics) CallStarted(service, method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.started = append(m.started, service+"/"+method)
+}
+
+func (m *recordingMetrics) CallFinished(service, method string, code codes.Code, d time.Duration, retries int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.finished = append(m.finished, service+"/"+method)
+	m.codes = append(m.codes, code)
+	m.retries = append(m.retries, retries)
+}
+
+func (m *recordingMetrics) ConnStateChanged(service string, from, to connectivity.State) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.states) == 0 {
+		m.states = append(m.states, from)
+	}
+	m.states = append(m.states, to)
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	m := &recordingMetrics{}
+	i := metricsInterceptor{m: m}
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		for _, opt := range opts {
+			if tr, ok := opt.(grpc.TrailerCallOption); ok {
+				*tr.TrailerAddr = metadata.Pairs("grpc-previous-rpc-attempts", "1")
+			}
+		}
+		return status.Error(codes.DeadlineExceeded, "slow")
+	}
+	i.unary(context.Background(), "/feed.LandmarkFeed/GetFeed", nil, nil, nil, invoker)
+	if len(m.started) != 1 || len(m.finished) != 1 || m.finished[0] != "feed.LandmarkFeed/GetFeed" {
+		t.Fatalf("unexpected calls %v %v", m.started, m.finished)
+	}
+	if m.codes[0] != codes.DeadlineExceeded || m.retries[0] != 1 {
+		t.Errorf("expected DeadlineExceeded after 1 retry, got %s after %d", m.codes[0], m.retries[0])
+	}
+}
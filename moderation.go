@@ -0,0 +1,216 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type ModerationAction int
+
+const (
+	ModerationAllow ModerationAction = iota
+	// ModerationFlag lets the comment through and reports it to
+	// ModerationOptions.OnFlagged once it is written
+	ModerationFlag
+	// ModerationRedact replaces the comment text with the one in the result
+	ModerationRedact
+	ModerationReject
+)
+
+func (a ModerationAction) String() string {
+	switch a {
+	case ModerationAllow:
+		return "allow"
+	case ModerationFlag:
+		return "flag"
+	case ModerationRedact:
+		return "redact"
+	case ModerationReject:
+		return "reject"
+	}
+	return fmt.Sprintf("ModerationAction(%d)", int(a))
+}
+
+type ModerationResult struct {
+	Action ModerationAction
+	// Text replaces the comment text when Action is ModerationRedact
+	Text   string
+	Reason string
+}
+
+// Moderator inspects a comment before it is created or edited. For edits
+// only Id, UserId and Text are set
+type Moderator interface {
+	Moderate(ctx context.Context, comment *Comment) (ModerationResult, error)
+}
+
+type ModeratorFunc func(ctx context.Context, comment *Comment) (ModerationResult, error)
+
+func (f ModeratorFunc) Moderate(ctx context.Context, comment *Comment) (ModerationResult, error) {
+	return f(ctx, comment)
+}
+
+type ModerationOptions struct {
+	// Moderators run in order, each seeing the text redacted by the
+	// previous ones. The first rejection stops the write
+	Moderators []Moderator
+	// OnFlagged is called after a flagged comment has been written
+	OnFlagged func(ctx context.Context, comment *Comment, reasons []string)
+}
+
+var ErrCommentRejected = errors.New("comment rejected by moderation")
+
+type ModerationError struct {
+	Reason string
+}
+
+func (e *ModerationError) Error() string {
+	return ErrCommentRejected.Error() + ": " + e.Reason
+}
+
+func (e *ModerationError) Is(target error) bool {
+	return target == ErrCommentRejected
+}
+
+// moderate runs the moderators on the comment, redacting its text in
+// place, and returns the reasons it was flagged for
+func (c *Client) moderate(ctx context.Context, comment *Comment) ([]string, error) {
+	if c.moderation == nil {
+		return nil, nil
+	}
+	var flags []string
+	for _, m := range c.moderation.Moderators {
+		res, err := m.Moderate(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
+		switch res.Action {
+		case ModerationReject:
+			return nil, &ModerationError{Reason: res.Reason}
+		case ModerationRedact:
+			comment.Text = res.Text
+		case ModerationFlag:
+			flags = append(flags, res.Reason)
+		}
+	}
+	return flags, nil
+}
+
+func (c *Client) reportFlagged(ctx context.Context, comment *Comment, reasons []string) {
+	if len(reasons) > 0 && c.moderation.OnFlagged != nil {
+		c.moderation.OnFlagged(ctx, comment, reasons)
+	}
+}
+
+// WordListModerator matches listed words case-insensitively against the
+// words of the text. With ModerationRedact matched words are replaced with
+// asterisks
+type WordListModerator struct {
+	words  map[string]bool
+	action ModerationAction
+}
+
+func NewWordListModerator(words []string, action ModerationAction) *WordListModerator {
+	m := &WordListModerator{words: make(map[string]bool, len(words)), action: action}
+	for _, w := range words {
+		m.words[strings.ToLower(w)] = true
+	}
+	return m
+}
+
+func (m *WordListModerator) Moderate(ctx context.Context, comment *Comment) (ModerationResult, error) {
+	var (
+		b       strings.Builder
+		matched bool
+		start   = -1
+	)
+	// flush handles the word in text[start:end], if any
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		word := comment.Text[start:end]
+		if m.words[strings.ToLower(word)] {
+			matched = true
+			b.WriteString(strings.Repeat("*", utf8.RuneCountInString(word)))
+		} else {
+			b.WriteString(word)
+		}
+		start = -1
+	}
+	for i, r := range comment.Text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+		b.WriteRune(r)
+	}
+	flush(len(comment.Text))
+	if !matched {
+		return ModerationResult{}, nil
+	}
+	res := ModerationResult{Action: m.action, Reason: "contains a listed word"}
+	if m.action == ModerationRedact {
+		res.Text = b.String()
+	}
+	return res, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(https?://|www\.)\S+`)
+
+// LimitsModerator rejects comments longer than MaxLength characters or
+// with more than MaxLinks links. Zero disables a limit
+type LimitsModerator struct {
+	MaxLength int
+	MaxLinks  int
+}
+
+func (m LimitsModerator) Moderate(ctx context.Context, comment *Comment) (ModerationResult, error) {
+	if n := utf8.RuneCountInString(comment.Text); m.MaxLength > 0 && n > m.MaxLength {
+		return ModerationResult{
+			Action: ModerationReject,
+			Reason: fmt.Sprintf("text is %d characters long, at most %d allowed", n, m.MaxLength),
+		}, nil
+	}
+	if n := len(linkPattern.FindAllStringIndex(comment.Text, -1)); m.MaxLinks > 0 && n > m.MaxLinks {
+		return ModerationResult{
+			Action: ModerationReject,
+			Reason: fmt.Sprintf("text has %d links, at most %d allowed", n, m.MaxLinks),
+		}, nil
+	}
+	return ModerationResult{}, nil
+}
+
+// AttachmentModerator rejects comments with attachment ids that are not
+// UUIDs or with more than MaxAttachments attachments, if set
+type AttachmentModerator struct {
+	MaxAttachments int
+}
+
+func (m AttachmentModerator) Moderate(ctx context.Context, comment *Comment) (ModerationResult, error) {
+	if m.MaxAttachments > 0 && len(comment.Attachments) > m.MaxAttachments {
+		return ModerationResult{
+			Action: ModerationReject,
+			Reason: fmt.Sprintf("%d attachments, at most %d allowed", len(comment.Attachments), m.MaxAttachments),
+		}, nil
+	}
+	for _, id := range comment.Attachments {
+		if _, err := uuid.Parse(id); err != nil {
+			return ModerationResult{
+				Action: ModerationReject,
+				Reason: fmt.Sprintf("invalid attachment id %q", id),
+			}, nil
+		}
+	}
+	return ModerationResult{}, nil
+}
@@ -0,0 +1,104 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+)
+
+func TestWordListModerator(t *testing.T) {
+	m := NewWordListModerator([]string{"darn", "блин"}, ModerationRedact)
+	res, err := m.Moderate(context.Background(), &Comment{Text: "Darn, the museum was closed. Блин! Darnedest day"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Action != ModerationRedact {
+		t.Fatalf("expected redact, got %s", res.Action)
+	}
+	if want := "****, the museum was closed. ****! Darnedest day"; res.Text != want {
+		t.Errorf("expected %q, got %q", want, res.Text)
+	}
+
+	res, _ = m.Moderate(context.Background(), &Comment{Text: "Lovely view"})
+	if res.Action != ModerationAllow {
+		t.Errorf("expected allow, got %s", res.Action)
+	}
+}
+
+func TestLimitsModerator(t *testing.T) {
+	m := LimitsModerator{MaxLength: 40, MaxLinks: 1}
+	for text, want := range map[string]ModerationAction{
+		"Short and sweet":                    ModerationAllow,
+		"See https://example.com":            ModerationAllow,
+		"http://a.example and www.b.example": ModerationReject,
+		strings.Repeat("long ", 10):          ModerationReject,
+		strings.Repeat("ё", 40):              ModerationAllow,
+	} {
+		res, err := m.Moderate(context.Background(), &Comment{Text: text})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Action != want {
+			t.Errorf("%q: expected %s, got %s (%s)", text, want, res.Action, res.Reason)
+		}
+	}
+}
+
+func TestCommentModeration(t *testing.T) {
+	const (
+		parentId = "712d6060-284b-400b-805d-cc118cd41c5d"
+		authorId = "30de160d-e48c-4beb-94c3-b5c6eb8075e6"
+	)
+	f := newFakeStorage()
+	client := f.client()
+	var flagged []string
+	client.moderation = &ModerationOptions{
+		Moderators: []Moderator{
+			AttachmentModerator{},
+			NewWordListModerator([]string{"darn"}, ModerationRedact),
+			ModeratorFunc(func(ctx context.Context, comment *Comment) (ModerationResult, error) {
+				if strings.Contains(comment.Text, "refund") {
+					return ModerationResult{Action: ModerationFlag, Reason: "mentions refunds"}, nil
+				}
+				return ModerationResult{}, nil
+			}),
+		},
+		OnFlagged: func(ctx context.Context, comment *Comment, reasons []string) {
+			flagged = append(flagged, comment.Id)
+			if !reflect.DeepEqual(reasons, []string{"mentions refunds"}) {
+				t.Errorf("unexpected reasons %v", reasons)
+			}
+		},
+	}
+	ctx := context.Background()
+
+	_, err := client.CreateComment(ctx, parentId, authorId, "Nice", []string{"invalidId"}, 5)
+	if !errors.Is(err, ErrCommentRejected) {
+		t.Fatalf("expected a rejection, got %v", err)
+	}
+	if f.calls["CreateComment"] != 0 {
+		t.Fatal("rejected comment was written")
+	}
+
+	comment, err := client.CreateComment(ctx, parentId, authorId, "darn queue, want a refund", []string{gofakeit.UUID()}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored := f.comments[parentId][0].Text; stored != "**** queue, want a refund" {
+		t.Errorf("expected redacted text to be stored, got %q", stored)
+	}
+	if !reflect.DeepEqual(flagged, []string{comment.Id}) {
+		t.Errorf("expected %s to be flagged, got %v", comment.Id, flagged)
+	}
+
+	if err = client.EditComment(ctx, authorId, comment.Id, "Darn good after all"); err != nil {
+		t.Fatal(err)
+	}
+	if stored := f.comments[parentId][0].Text; stored != "**** good after all" {
+		t.Errorf("expected redacted edit to be stored, got %q", stored)
+	}
+}
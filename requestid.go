@@ -0,0 +1,132 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys the request id and the acting user are sent under
+const (
+	RequestIDHeader = "x-request-id"
+	ActorHeader     = "x-actor-id"
+)
+
+type requestIDKey struct{}
+
+type actorKey struct{}
+
+// WithRequestID sets the id sent with every call made with ctx. Calls made
+// without one are sent with a generated id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// WithActor sets the user on whose behalf calls made with ctx are made
+func WithActor(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, actorKey{}, userId)
+}
+
+func ActorFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(actorKey{}).(string)
+	return id, ok && id != ""
+}
+
+// RequestError carries the request id of the call that failed. It reports
+// the status of the underlying error, so status.Code and status.FromError
+// work on it as on the bare error
+type RequestError struct {
+	RequestID string
+	Err       error
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error() + " (request id " + e.RequestID + ")"
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+func (e *RequestError) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+// RequestIDFromError returns the request id of the call err was returned by
+func RequestIDFromError(err error) (string, bool) {
+	var e *RequestError
+	if errors.As(err, &e) {
+		return e.RequestID, true
+	}
+	return "", false
+}
+
+// requestMetadata sends the request id and the actor of ctx with every
+// call. It runs first so that the other interceptors see the id
+type requestMetadata struct{}
+
+func (i requestMetadata) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.unary),
+		grpc.WithChainStreamInterceptor(i.stream),
+	}
+}
+
+func (requestMetadata) attach(ctx context.Context) (context.Context, string) {
+	id, ok := RequestIDFromContext(ctx)
+	if !ok {
+		id = uuid.NewString()
+		ctx = WithRequestID(ctx, id)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, id)
+	if actor, ok := ActorFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, ActorHeader, actor)
+	}
+	return ctx, id
+}
+
+func (i requestMetadata) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, id := i.attach(ctx)
+	return withRequestID(invoker(ctx, method, req, reply, cc, opts...), id)
+}
+
+func (i requestMetadata) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, id := i.attach(ctx)
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, withRequestID(err, id)
+	}
+	return &requestIDStream{ClientStream: s, id: id}, nil
+}
+
+func withRequestID(err error, id string) error {
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return &RequestError{RequestID: id, Err: err}
+}
+
+// requestIDStream adds the request id to the errors of a stream, except
+// io.EOF which marks its end
+type requestIDStream struct {
+	grpc.ClientStream
+	id string
+}
+
+func (s *requestIDStream) SendMsg(m any) error {
+	return withRequestID(s.ClientStream.SendMsg(m), s.id)
+}
+
+func (s *requestIDStream) RecvMsg(m any) error {
+	return withRequestID(s.ClientStream.RecvMsg(m), s.id)
+}
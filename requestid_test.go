@@ -0,0 +1,47 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return status.Error(codes.NotFound, "no such landmark")
+	}
+	ctx := WithActor(WithRequestID(context.Background(), "req-1"), "user")
+	err := requestMetadata{}.unary(ctx, "/storage.StorageService/GetLandmark", nil, nil, nil, invoker)
+	if got := md.Get(RequestIDHeader); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("expected request id req-1, got %v", got)
+	}
+	if got := md.Get(ActorHeader); len(got) != 1 || got[0] != "user" {
+		t.Errorf("expected actor user, got %v", got)
+	}
+	if id, ok := RequestIDFromError(err); !ok || id != "req-1" {
+		t.Errorf("expected req-1 in the error, got %q", id)
+	}
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected the status to survive, got %v", err)
+	}
+	if s, _ := status.FromError(err); s.Message() != "no such landmark" {
+		t.Errorf("unexpected status message %q", s.Message())
+	}
+
+	err = requestMetadata{}.unary(context.Background(), "/storage.StorageService/GetLandmark", nil, nil, nil, invoker)
+	generated := md.Get(RequestIDHeader)
+	if len(generated) != 1 || generated[0] == "" || len(md.Get(ActorHeader)) != 0 {
+		t.Fatalf("expected only a generated request id, got %v", md)
+	}
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || reqErr.RequestID != generated[0] {
+		t.Errorf("expected the generated id %s in the error, got %v", generated[0], err)
+	}
+}
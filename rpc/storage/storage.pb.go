@@ -20,6 +20,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReactionKind int32
+
+const (
+	ReactionKind_REACTION_NONE    ReactionKind = 0
+	ReactionKind_REACTION_HELPFUL ReactionKind = 1
+	ReactionKind_REACTION_LIKE    ReactionKind = 2
+)
+
+// Enum value maps for ReactionKind.
+var (
+	ReactionKind_name = map[int32]string{
+		0: "REACTION_NONE",
+		1: "REACTION_HELPFUL",
+		2: "REACTION_LIKE",
+	}
+	ReactionKind_value = map[string]int32{
+		"REACTION_NONE":    0,
+		"REACTION_HELPFUL": 1,
+		"REACTION_LIKE":    2,
+	}
+)
+
+func (x ReactionKind) Enum() *ReactionKind {
+	p := new(ReactionKind)
+	*p = x
+	return p
+}
+
+func (x ReactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_storage_proto_enumTypes[0].Descriptor()
+}
+
+func (ReactionKind) Type() protoreflect.EnumType {
+	return &file_storage_proto_enumTypes[0]
+}
+
+func (x ReactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactionKind.Descriptor instead.
+func (ReactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{0}
+}
+
+type FriendRequestState int32
+
+const (
+	FriendRequestState_FRIEND_REQUEST_STATE_UNSPECIFIED FriendRequestState = 0
+	FriendRequestState_FRIEND_REQUEST_PENDING           FriendRequestState = 1
+	FriendRequestState_FRIEND_REQUEST_ACCEPTED          FriendRequestState = 2
+	FriendRequestState_FRIEND_REQUEST_DECLINED          FriendRequestState = 3
+	FriendRequestState_FRIEND_REQUEST_CANCELLED         FriendRequestState = 4
+)
+
+// Enum value maps for FriendRequestState.
+var (
+	FriendRequestState_name = map[int32]string{
+		0: "FRIEND_REQUEST_STATE_UNSPECIFIED",
+		1: "FRIEND_REQUEST_PENDING",
+		2: "FRIEND_REQUEST_ACCEPTED",
+		3: "FRIEND_REQUEST_DECLINED",
+		4: "FRIEND_REQUEST_CANCELLED",
+	}
+	FriendRequestState_value = map[string]int32{
+		"FRIEND_REQUEST_STATE_UNSPECIFIED": 0,
+		"FRIEND_REQUEST_PENDING":           1,
+		"FRIEND_REQUEST_ACCEPTED":          2,
+		"FRIEND_REQUEST_DECLINED":          3,
+		"FRIEND_REQUEST_CANCELLED":         4,
+	}
+)
+
+func (x FriendRequestState) Enum() *FriendRequestState {
+	p := new(FriendRequestState)
+	*p = x
+	return p
+}
+
+func (x FriendRequestState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendRequestState) Descriptor() protoreflect.EnumDescriptor {
+	return file_storage_proto_enumTypes[1].Descriptor()
+}
+
+func (FriendRequestState) Type() protoreflect.EnumType {
+	return &file_storage_proto_enumTypes[1]
+}
+
+func (x FriendRequestState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendRequestState.Descriptor instead.
+func (FriendRequestState) EnumDescriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{1}
+}
+
 type Coordinates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -27,6 +131,9 @@ type Coordinates struct {
 
 	Longitude float32 `protobuf:"fixed32,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	Latitude  float32 `protobuf:"fixed32,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Full precision coordinates, preferred over the float fields when set
+	Longitude64 *float64 `protobuf:"fixed64,3,opt,name=longitude64,proto3,oneof" json:"longitude64,omitempty"`
+	Latitude64  *float64 `protobuf:"fixed64,4,opt,name=latitude64,proto3,oneof" json:"latitude64,omitempty"`
 }
 
 func (x *Coordinates) Reset() {
@@ -75,6 +182,20 @@ func (x *Coordinates) GetLatitude() float32 {
 	return 0
 }
 
+func (x *Coordinates) GetLongitude64() float64 {
+	if x != nil && x.Longitude64 != nil {
+		return *x.Longitude64
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLatitude64() float64 {
+	if x != nil && x.Latitude64 != nil {
+		return *x.Latitude64
+	}
+	return 0
+}
+
 type GetLandmarkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -198,10 +319,12 @@ type AddLandmarkRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Score     float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
-	Latitude  float32 `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32 `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score       float32  `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Latitude    float32  `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32  `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude64  *float64 `protobuf:"fixed64,5,opt,name=latitude64,proto3,oneof" json:"latitude64,omitempty"`
+	Longitude64 *float64 `protobuf:"fixed64,6,opt,name=longitude64,proto3,oneof" json:"longitude64,omitempty"`
 }
 
 func (x *AddLandmarkRequest) Reset() {
@@ -264,6 +387,20 @@ func (x *AddLandmarkRequest) GetLongitude() float32 {
 	return 0
 }
 
+func (x *AddLandmarkRequest) GetLatitude64() float64 {
+	if x != nil && x.Latitude64 != nil {
+		return *x.Latitude64
+	}
+	return 0
+}
+
+func (x *AddLandmarkRequest) GetLongitude64() float64 {
+	if x != nil && x.Longitude64 != nil {
+		return *x.Longitude64
+	}
+	return 0
+}
+
 type AddLandmarkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -680,10 +817,12 @@ type RecommendLandmarksRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Amount    int64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Latitude  float32 `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32 `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	UserId      string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount      int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Latitude    float32  `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32  `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude64  *float64 `protobuf:"fixed64,5,opt,name=latitude64,proto3,oneof" json:"latitude64,omitempty"`
+	Longitude64 *float64 `protobuf:"fixed64,6,opt,name=longitude64,proto3,oneof" json:"longitude64,omitempty"`
 }
 
 func (x *RecommendLandmarksRequest) Reset() {
@@ -746,6 +885,20 @@ func (x *RecommendLandmarksRequest) GetLongitude() float32 {
 	return 0
 }
 
+func (x *RecommendLandmarksRequest) GetLatitude64() float64 {
+	if x != nil && x.Latitude64 != nil {
+		return *x.Latitude64
+	}
+	return 0
+}
+
+func (x *RecommendLandmarksRequest) GetLongitude64() float64 {
+	if x != nil && x.Longitude64 != nil {
+		return *x.Longitude64
+	}
+	return 0
+}
+
 type RecommendLandmarksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -982,6 +1135,9 @@ type CreateCommentRequest struct {
 	Text        string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	Attachments []string `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	Rating      int32    `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Id of the comment being replied to. The parent is taken from that
+	// comment when parentId is empty
+	ReplyId string `protobuf:"bytes,6,opt,name=replyId,proto3" json:"replyId,omitempty"`
 }
 
 func (x *CreateCommentRequest) Reset() {
@@ -1051,10 +1207,20 @@ func (x *CreateCommentRequest) GetRating() int32 {
 	return 0
 }
 
+func (x *CreateCommentRequest) GetReplyId() string {
+	if x != nil {
+		return x.ReplyId
+	}
+	return ""
+}
+
 type CreateCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *CreateCommentResponse) Reset() {
@@ -1089,6 +1255,20 @@ func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{20}
 }
 
+func (x *CreateCommentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateCommentResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 type DeleteCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1296,6 +1476,14 @@ type Comment struct {
 	Text        string   `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
 	ReplyId     string   `protobuf:"bytes,7,opt,name=replyId,proto3" json:"replyId,omitempty"`
 	Timestamp   int64    `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 0 if the comment has never been edited
+	EditedAt     int64 `protobuf:"varint,9,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+	Deleted      bool  `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Revisions    int32 `protobuf:"varint,11,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	HelpfulCount int64 `protobuf:"varint,12,opt,name=helpfulCount,proto3" json:"helpfulCount,omitempty"`
+	LikeCount    int64 `protobuf:"varint,13,opt,name=likeCount,proto3" json:"likeCount,omitempty"`
+	// Reaction of the user passed to GetComments
+	UserReaction ReactionKind `protobuf:"varint,14,opt,name=userReaction,proto3,enum=landmark.storage.ReactionKind" json:"userReaction,omitempty"`
 }
 
 func (x *Comment) Reset() {
@@ -1386,6 +1574,48 @@ func (x *Comment) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Comment) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetRevisions() int32 {
+	if x != nil {
+		return x.Revisions
+	}
+	return 0
+}
+
+func (x *Comment) GetHelpfulCount() int64 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *Comment) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Comment) GetUserReaction() ReactionKind {
+	if x != nil {
+		return x.UserReaction
+	}
+	return ReactionKind_REACTION_NONE
+}
+
 type GetCommentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1395,6 +1625,8 @@ type GetCommentsRequest struct {
 	Limit      int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset     int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	UserId     string `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	// Soft deleted comments are left out unless set
+	IncludeDeleted bool `protobuf:"varint,5,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
 }
 
 func (x *GetCommentsRequest) Reset() {
@@ -1457,6 +1689,13 @@ func (x *GetCommentsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetCommentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetCommentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1504,18 +1743,24 @@ func (x *GetCommentsResponse) GetComments() []*Comment {
 	return nil
 }
 
-type GetProfileCommentsRequest struct {
+type RatingSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-}
-
-func (x *GetProfileCommentsRequest) Reset() {
-	*x = GetProfileCommentsRequest{}
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	// histogram[i] is the number of reviews with grade i+1, grades are 1 to 5
+	Histogram []int64 `protobuf:"varint,2,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	Count     int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Mean      float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Mean shrunk towards the global mean for landmarks with few reviews
+	BayesianMean float64 `protobuf:"fixed64,5,opt,name=bayesianMean,proto3" json:"bayesianMean,omitempty"`
+	// Mean with recent reviews weighted higher
+	RecencyWeightedMean float64 `protobuf:"fixed64,6,opt,name=recencyWeightedMean,proto3" json:"recencyWeightedMean,omitempty"`
+}
+
+func (x *RatingSummary) Reset() {
+	*x = RatingSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1523,13 +1768,13 @@ func (x *GetProfileCommentsRequest) Reset() {
 	}
 }
 
-func (x *GetProfileCommentsRequest) String() string {
+func (x *RatingSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileCommentsRequest) ProtoMessage() {}
+func (*RatingSummary) ProtoMessage() {}
 
-func (x *GetProfileCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *RatingSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,42 +1786,63 @@ func (x *GetProfileCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileCommentsRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RatingSummary.ProtoReflect.Descriptor instead.
+func (*RatingSummary) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetProfileCommentsRequest) GetUserId() string {
+func (x *RatingSummary) GetLandmarkId() string {
 	if x != nil {
-		return x.UserId
+		return x.LandmarkId
 	}
 	return ""
 }
 
-func (x *GetProfileCommentsRequest) GetLimit() int32 {
+func (x *RatingSummary) GetHistogram() []int64 {
 	if x != nil {
-		return x.Limit
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *RatingSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *GetProfileCommentsRequest) GetOffset() int32 {
+func (x *RatingSummary) GetMean() float64 {
 	if x != nil {
-		return x.Offset
+		return x.Mean
 	}
 	return 0
 }
 
-type GetProfileCommentsResponse struct {
+func (x *RatingSummary) GetBayesianMean() float64 {
+	if x != nil {
+		return x.BayesianMean
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetRecencyWeightedMean() float64 {
+	if x != nil {
+		return x.RecencyWeightedMean
+	}
+	return 0
+}
+
+type GetRatingSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
 }
 
-func (x *GetProfileCommentsResponse) Reset() {
-	*x = GetProfileCommentsResponse{}
+func (x *GetRatingSummaryRequest) Reset() {
+	*x = GetRatingSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1584,13 +1850,13 @@ func (x *GetProfileCommentsResponse) Reset() {
 	}
 }
 
-func (x *GetProfileCommentsResponse) String() string {
+func (x *GetRatingSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileCommentsResponse) ProtoMessage() {}
+func (*GetRatingSummaryRequest) ProtoMessage() {}
 
-func (x *GetProfileCommentsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRatingSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1602,32 +1868,28 @@ func (x *GetProfileCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileCommentsResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileCommentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRatingSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingSummaryRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetProfileCommentsResponse) GetComments() []*Comment {
+func (x *GetRatingSummaryRequest) GetLandmarkId() string {
 	if x != nil {
-		return x.Comments
+		return x.LandmarkId
 	}
-	return nil
+	return ""
 }
 
-type GetFavouriteLandmarksRequest struct {
+type GetRatingSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string       `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Limit     int32        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Northeast *Coordinates `protobuf:"bytes,3,opt,name=northeast,proto3" json:"northeast,omitempty"`
-	Southeast *Coordinates `protobuf:"bytes,4,opt,name=southeast,proto3" json:"southeast,omitempty"`
-	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Summary *RatingSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (x *GetFavouriteLandmarksRequest) Reset() {
-	*x = GetFavouriteLandmarksRequest{}
+func (x *GetRatingSummaryResponse) Reset() {
+	*x = GetRatingSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1635,13 +1897,13 @@ func (x *GetFavouriteLandmarksRequest) Reset() {
 	}
 }
 
-func (x *GetFavouriteLandmarksRequest) String() string {
+func (x *GetRatingSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavouriteLandmarksRequest) ProtoMessage() {}
+func (*GetRatingSummaryResponse) ProtoMessage() {}
 
-func (x *GetFavouriteLandmarksRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRatingSummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1653,56 +1915,28 @@ func (x *GetFavouriteLandmarksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavouriteLandmarksRequest.ProtoReflect.Descriptor instead.
-func (*GetFavouriteLandmarksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRatingSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingSummaryResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetFavouriteLandmarksRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetFavouriteLandmarksRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetFavouriteLandmarksRequest) GetNortheast() *Coordinates {
-	if x != nil {
-		return x.Northeast
-	}
-	return nil
-}
-
-func (x *GetFavouriteLandmarksRequest) GetSoutheast() *Coordinates {
+func (x *GetRatingSummaryResponse) GetSummary() *RatingSummary {
 	if x != nil {
-		return x.Southeast
+		return x.Summary
 	}
 	return nil
 }
 
-func (x *GetFavouriteLandmarksRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-type GetFavouriteLandmarksResponse struct {
+type GetRatingSummariesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	LandmarkIds []string `protobuf:"bytes,1,rep,name=landmarkIds,proto3" json:"landmarkIds,omitempty"`
 }
 
-func (x *GetFavouriteLandmarksResponse) Reset() {
-	*x = GetFavouriteLandmarksResponse{}
+func (x *GetRatingSummariesRequest) Reset() {
+	*x = GetRatingSummariesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,13 +1944,13 @@ func (x *GetFavouriteLandmarksResponse) Reset() {
 	}
 }
 
-func (x *GetFavouriteLandmarksResponse) String() string {
+func (x *GetRatingSummariesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavouriteLandmarksResponse) ProtoMessage() {}
+func (*GetRatingSummariesRequest) ProtoMessage() {}
 
-func (x *GetFavouriteLandmarksResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRatingSummariesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,28 +1962,28 @@ func (x *GetFavouriteLandmarksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavouriteLandmarksResponse.ProtoReflect.Descriptor instead.
-func (*GetFavouriteLandmarksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRatingSummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingSummariesRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetFavouriteLandmarksResponse) GetIds() []string {
+func (x *GetRatingSummariesRequest) GetLandmarkIds() []string {
 	if x != nil {
-		return x.Ids
+		return x.LandmarkIds
 	}
 	return nil
 }
 
-type GetLikesAmountRequest struct {
+type GetRatingSummariesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Summaries []*RatingSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
 }
 
-func (x *GetLikesAmountRequest) Reset() {
-	*x = GetLikesAmountRequest{}
+func (x *GetRatingSummariesResponse) Reset() {
+	*x = GetRatingSummariesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1757,13 +1991,13 @@ func (x *GetLikesAmountRequest) Reset() {
 	}
 }
 
-func (x *GetLikesAmountRequest) String() string {
+func (x *GetRatingSummariesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikesAmountRequest) ProtoMessage() {}
+func (*GetRatingSummariesResponse) ProtoMessage() {}
 
-func (x *GetLikesAmountRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRatingSummariesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,28 +2009,30 @@ func (x *GetLikesAmountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikesAmountRequest.ProtoReflect.Descriptor instead.
-func (*GetLikesAmountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRatingSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingSummariesResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetLikesAmountRequest) GetUserId() string {
+func (x *GetRatingSummariesResponse) GetSummaries() []*RatingSummary {
 	if x != nil {
-		return x.UserId
+		return x.Summaries
 	}
-	return ""
+	return nil
 }
 
-type GetLikesAmountResponse struct {
+type CommentReaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	UserId    string       `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Kind      ReactionKind `protobuf:"varint,2,opt,name=kind,proto3,enum=landmark.storage.ReactionKind" json:"kind,omitempty"`
+	Timestamp int64        `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetLikesAmountResponse) Reset() {
-	*x = GetLikesAmountResponse{}
+func (x *CommentReaction) Reset() {
+	*x = CommentReaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,13 +2040,13 @@ func (x *GetLikesAmountResponse) Reset() {
 	}
 }
 
-func (x *GetLikesAmountResponse) String() string {
+func (x *CommentReaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLikesAmountResponse) ProtoMessage() {}
+func (*CommentReaction) ProtoMessage() {}
 
-func (x *GetLikesAmountResponse) ProtoReflect() protoreflect.Message {
+func (x *CommentReaction) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,28 +2058,45 @@ func (x *GetLikesAmountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLikesAmountResponse.ProtoReflect.Descriptor instead.
-func (*GetLikesAmountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommentReaction.ProtoReflect.Descriptor instead.
+func (*CommentReaction) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetLikesAmountResponse) GetCount() int32 {
+func (x *CommentReaction) GetUserId() string {
 	if x != nil {
-		return x.Count
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type GetUserTagsRequest struct {
+func (x *CommentReaction) GetKind() ReactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReactionKind_REACTION_NONE
+}
+
+func (x *CommentReaction) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// A user has at most one reaction per comment, reacting again replaces it
+type ReactToCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserId    string       `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommentId string       `protobuf:"bytes,2,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	Kind      ReactionKind `protobuf:"varint,3,opt,name=kind,proto3,enum=landmark.storage.ReactionKind" json:"kind,omitempty"`
 }
 
-func (x *GetUserTagsRequest) Reset() {
-	*x = GetUserTagsRequest{}
+func (x *ReactToCommentRequest) Reset() {
+	*x = ReactToCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1851,13 +2104,13 @@ func (x *GetUserTagsRequest) Reset() {
 	}
 }
 
-func (x *GetUserTagsRequest) String() string {
+func (x *ReactToCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTagsRequest) ProtoMessage() {}
+func (*ReactToCommentRequest) ProtoMessage() {}
 
-func (x *GetUserTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *ReactToCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1869,28 +2122,40 @@ func (x *GetUserTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTagsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactToCommentRequest.ProtoReflect.Descriptor instead.
+func (*ReactToCommentRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetUserTagsRequest) GetUserId() string {
+func (x *ReactToCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUserTagsResponse struct {
+func (x *ReactToCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *ReactToCommentRequest) GetKind() ReactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReactionKind_REACTION_NONE
+}
+
+type ReactToCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *GetUserTagsResponse) Reset() {
-	*x = GetUserTagsResponse{}
+func (x *ReactToCommentResponse) Reset() {
+	*x = ReactToCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1898,13 +2163,13 @@ func (x *GetUserTagsResponse) Reset() {
 	}
 }
 
-func (x *GetUserTagsResponse) String() string {
+func (x *ReactToCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTagsResponse) ProtoMessage() {}
+func (*ReactToCommentResponse) ProtoMessage() {}
 
-func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReactToCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1916,29 +2181,22 @@ func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTagsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactToCommentResponse.ProtoReflect.Descriptor instead.
+func (*ReactToCommentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetUserTagsResponse) GetIds() []string {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-type GetFeaturesTopicsRequest struct {
+type UnreactToCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Amount int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=commentId,proto3" json:"commentId,omitempty"`
 }
 
-func (x *GetFeaturesTopicsRequest) Reset() {
-	*x = GetFeaturesTopicsRequest{}
+func (x *UnreactToCommentRequest) Reset() {
+	*x = UnreactToCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1946,13 +2204,13 @@ func (x *GetFeaturesTopicsRequest) Reset() {
 	}
 }
 
-func (x *GetFeaturesTopicsRequest) String() string {
+func (x *UnreactToCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeaturesTopicsRequest) ProtoMessage() {}
+func (*UnreactToCommentRequest) ProtoMessage() {}
 
-func (x *GetFeaturesTopicsRequest) ProtoReflect() protoreflect.Message {
+func (x *UnreactToCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1964,37 +2222,33 @@ func (x *GetFeaturesTopicsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeaturesTopicsRequest.ProtoReflect.Descriptor instead.
-func (*GetFeaturesTopicsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnreactToCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnreactToCommentRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetFeaturesTopicsRequest) GetUserId() string {
+func (x *UnreactToCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetFeaturesTopicsRequest) GetAmount() int32 {
+func (x *UnreactToCommentRequest) GetCommentId() string {
 	if x != nil {
-		return x.Amount
+		return x.CommentId
 	}
-	return 0
+	return ""
 }
 
-type FeaturedTopic struct {
+type UnreactToCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Illustration string `protobuf:"bytes,3,opt,name=illustration,proto3" json:"illustration,omitempty"`
 }
 
-func (x *FeaturedTopic) Reset() {
-	*x = FeaturedTopic{}
+func (x *UnreactToCommentResponse) Reset() {
+	*x = UnreactToCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,13 +2256,13 @@ func (x *FeaturedTopic) Reset() {
 	}
 }
 
-func (x *FeaturedTopic) String() string {
+func (x *UnreactToCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeaturedTopic) ProtoMessage() {}
+func (*UnreactToCommentResponse) ProtoMessage() {}
 
-func (x *FeaturedTopic) ProtoReflect() protoreflect.Message {
+func (x *UnreactToCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2020,42 +2274,23 @@ func (x *FeaturedTopic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeaturedTopic.ProtoReflect.Descriptor instead.
-func (*FeaturedTopic) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnreactToCommentResponse.ProtoReflect.Descriptor instead.
+func (*UnreactToCommentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *FeaturedTopic) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *FeaturedTopic) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *FeaturedTopic) GetIllustration() string {
-	if x != nil {
-		return x.Illustration
-	}
-	return ""
-}
-
-type GetFeaturedTopicsResponse struct {
+type GetCommentReactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topics []*FeaturedTopic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	CommentId string `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset    int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *GetFeaturedTopicsResponse) Reset() {
-	*x = GetFeaturedTopicsResponse{}
+func (x *GetCommentReactionsRequest) Reset() {
+	*x = GetCommentReactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,13 +2298,13 @@ func (x *GetFeaturedTopicsResponse) Reset() {
 	}
 }
 
-func (x *GetFeaturedTopicsResponse) String() string {
+func (x *GetCommentReactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeaturedTopicsResponse) ProtoMessage() {}
+func (*GetCommentReactionsRequest) ProtoMessage() {}
 
-func (x *GetFeaturedTopicsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCommentReactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,29 +2316,42 @@ func (x *GetFeaturedTopicsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeaturedTopicsResponse.ProtoReflect.Descriptor instead.
-func (*GetFeaturedTopicsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommentReactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentReactionsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetFeaturedTopicsResponse) GetTopics() []*FeaturedTopic {
+func (x *GetCommentReactionsRequest) GetCommentId() string {
 	if x != nil {
-		return x.Topics
+		return x.CommentId
 	}
-	return nil
+	return ""
 }
 
-type AddFriendRequest struct {
+func (x *GetCommentReactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentReactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetCommentReactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Reactions []*CommentReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
 }
 
-func (x *AddFriendRequest) Reset() {
-	*x = AddFriendRequest{}
+func (x *GetCommentReactionsResponse) Reset() {
+	*x = GetCommentReactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2111,13 +2359,13 @@ func (x *AddFriendRequest) Reset() {
 	}
 }
 
-func (x *AddFriendRequest) String() string {
+func (x *GetCommentReactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFriendRequest) ProtoMessage() {}
+func (*GetCommentReactionsResponse) ProtoMessage() {}
 
-func (x *AddFriendRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCommentReactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2129,33 +2377,35 @@ func (x *AddFriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFriendRequest.ProtoReflect.Descriptor instead.
-func (*AddFriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommentReactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentReactionsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AddFriendRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *AddFriendRequest) GetReceiver() string {
+func (x *GetCommentReactionsResponse) GetReactions() []*CommentReaction {
 	if x != nil {
-		return x.Receiver
+		return x.Reactions
 	}
-	return ""
+	return nil
 }
 
-type AddFriendResponse struct {
+// An upload is an AttachmentInfo message, the file contents split over
+// any number of chunk messages and the hex SHA-256 of the contents last.
+// A checksum mismatch fails the upload with DATA_LOSS
+type UploadAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	//	*UploadAttachmentRequest_Sha256
+	Payload isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *AddFriendResponse) Reset() {
-	*x = AddFriendResponse{}
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2163,13 +2413,13 @@ func (x *AddFriendResponse) Reset() {
 	}
 }
 
-func (x *AddFriendResponse) String() string {
+func (x *UploadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFriendResponse) ProtoMessage() {}
+func (*UploadAttachmentRequest) ProtoMessage() {}
 
-func (x *AddFriendResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2181,22 +2431,72 @@ func (x *AddFriendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFriendResponse.ProtoReflect.Descriptor instead.
-func (*AddFriendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{40}
 }
 
-type DeleteFriendRequest struct {
+func (m *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentInfo {
+	if x, ok := x.GetPayload().(*UploadAttachmentRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*UploadAttachmentRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetSha256() string {
+	if x, ok := x.GetPayload().(*UploadAttachmentRequest_Sha256); ok {
+		return x.Sha256
+	}
+	return ""
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Sha256 struct {
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Sha256) isUploadAttachmentRequest_Payload() {}
+
+type AttachmentInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	UploaderId  string `protobuf:"bytes,1,opt,name=uploaderId,proto3" json:"uploaderId,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
-func (x *DeleteFriendRequest) Reset() {
-	*x = DeleteFriendRequest{}
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2204,13 +2504,13 @@ func (x *DeleteFriendRequest) Reset() {
 	}
 }
 
-func (x *DeleteFriendRequest) String() string {
+func (x *AttachmentInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFriendRequest) ProtoMessage() {}
+func (*AttachmentInfo) ProtoMessage() {}
 
-func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2222,33 +2522,36 @@ func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFriendRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteFriendRequest) GetSender() string {
+func (x *AttachmentInfo) GetUploaderId() string {
 	if x != nil {
-		return x.Sender
+		return x.UploaderId
 	}
 	return ""
 }
 
-func (x *DeleteFriendRequest) GetReceiver() string {
+func (x *AttachmentInfo) GetContentType() string {
 	if x != nil {
-		return x.Receiver
+		return x.ContentType
 	}
 	return ""
 }
 
-type DeleteFriendResponse struct {
+type UploadAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *DeleteFriendResponse) Reset() {
-	*x = DeleteFriendResponse{}
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2256,13 +2559,13 @@ func (x *DeleteFriendResponse) Reset() {
 	}
 }
 
-func (x *DeleteFriendResponse) String() string {
+func (x *UploadAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFriendResponse) ProtoMessage() {}
+func (*UploadAttachmentResponse) ProtoMessage() {}
 
-func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2274,21 +2577,40 @@ func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFriendResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFriendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{42}
 }
 
-type GetFriendsRequest struct {
+func (x *UploadAttachmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadAttachmentResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AttachmentMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UploaderId  string `protobuf:"bytes,2,opt,name=uploaderId,proto3" json:"uploaderId,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256      string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Timestamp   int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetFriendsRequest) Reset() {
-	*x = GetFriendsRequest{}
+func (x *AttachmentMeta) Reset() {
+	*x = AttachmentMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,13 +2618,13 @@ func (x *GetFriendsRequest) Reset() {
 	}
 }
 
-func (x *GetFriendsRequest) String() string {
+func (x *AttachmentMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendsRequest) ProtoMessage() {}
+func (*AttachmentMeta) ProtoMessage() {}
 
-func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
+func (x *AttachmentMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2314,42 +2636,78 @@ func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
-func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentMeta.ProtoReflect.Descriptor instead.
+func (*AttachmentMeta) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetFriendsRequest) GetUserId() string {
+func (x *AttachmentMeta) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-type GetFriendsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AttachmentMeta) GetUploaderId() string {
+	if x != nil {
+		return x.UploaderId
+	}
+	return ""
+}
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+func (x *AttachmentMeta) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *GetFriendsResponse) Reset() {
-	*x = GetFriendsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AttachmentMeta) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
+	return 0
 }
 
-func (x *GetFriendsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AttachmentMeta) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
 }
 
-func (*GetFriendsResponse) ProtoMessage() {}
+func (x *AttachmentMeta) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
 
-func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
+// Returns NOT_FOUND for unknown attachment ids
+type GetAttachmentMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetAttachmentMetaRequest) Reset() {
+	*x = GetAttachmentMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentMetaRequest) ProtoMessage() {}
+
+func (x *GetAttachmentMetaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2361,28 +2719,28 @@ func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendsResponse.ProtoReflect.Descriptor instead.
-func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAttachmentMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentMetaRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetFriendsResponse) GetIds() []string {
+func (x *GetAttachmentMetaRequest) GetId() string {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CountFriendsRequest struct {
+type GetAttachmentMetaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Meta *AttachmentMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 }
 
-func (x *CountFriendsRequest) Reset() {
-	*x = CountFriendsRequest{}
+func (x *GetAttachmentMetaResponse) Reset() {
+	*x = GetAttachmentMetaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2390,13 +2748,13 @@ func (x *CountFriendsRequest) Reset() {
 	}
 }
 
-func (x *CountFriendsRequest) String() string {
+func (x *GetAttachmentMetaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountFriendsRequest) ProtoMessage() {}
+func (*GetAttachmentMetaResponse) ProtoMessage() {}
 
-func (x *CountFriendsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAttachmentMetaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2408,28 +2766,30 @@ func (x *CountFriendsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountFriendsRequest.ProtoReflect.Descriptor instead.
-func (*CountFriendsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAttachmentMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentMetaResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CountFriendsRequest) GetUserId() string {
+func (x *GetAttachmentMetaResponse) GetMeta() *AttachmentMeta {
 	if x != nil {
-		return x.UserId
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type CountFriendsResponse struct {
+type CommentRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Text      string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EditorId  string `protobuf:"bytes,3,opt,name=editorId,proto3" json:"editorId,omitempty"`
 }
 
-func (x *CountFriendsResponse) Reset() {
-	*x = CountFriendsResponse{}
+func (x *CommentRevision) Reset() {
+	*x = CommentRevision{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2437,13 +2797,13 @@ func (x *CountFriendsResponse) Reset() {
 	}
 }
 
-func (x *CountFriendsResponse) String() string {
+func (x *CommentRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountFriendsResponse) ProtoMessage() {}
+func (*CommentRevision) ProtoMessage() {}
 
-func (x *CountFriendsResponse) ProtoReflect() protoreflect.Message {
+func (x *CommentRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2455,29 +2815,42 @@ func (x *CountFriendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountFriendsResponse.ProtoReflect.Descriptor instead.
-func (*CountFriendsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommentRevision.ProtoReflect.Descriptor instead.
+func (*CommentRevision) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CountFriendsResponse) GetCount() int32 {
+func (x *CommentRevision) GetText() string {
 	if x != nil {
-		return x.Count
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CommentRevision) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
 	}
 	return 0
 }
 
-type IsFriendRequest struct {
+func (x *CommentRevision) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+type GetCommentHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User1 string `protobuf:"bytes,1,opt,name=user1,proto3" json:"user1,omitempty"`
-	User2 string `protobuf:"bytes,2,opt,name=user2,proto3" json:"user2,omitempty"`
+	CommentId string `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
 }
 
-func (x *IsFriendRequest) Reset() {
-	*x = IsFriendRequest{}
+func (x *GetCommentHistoryRequest) Reset() {
+	*x = GetCommentHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2485,13 +2858,13 @@ func (x *IsFriendRequest) Reset() {
 	}
 }
 
-func (x *IsFriendRequest) String() string {
+func (x *GetCommentHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsFriendRequest) ProtoMessage() {}
+func (*GetCommentHistoryRequest) ProtoMessage() {}
 
-func (x *IsFriendRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCommentHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2503,35 +2876,29 @@ func (x *IsFriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsFriendRequest.ProtoReflect.Descriptor instead.
-func (*IsFriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *IsFriendRequest) GetUser1() string {
-	if x != nil {
-		return x.User1
-	}
-	return ""
-}
-
-func (x *IsFriendRequest) GetUser2() string {
+func (x *GetCommentHistoryRequest) GetCommentId() string {
 	if x != nil {
-		return x.User2
+		return x.CommentId
 	}
 	return ""
 }
 
-type IsFriendResponse struct {
+type GetCommentHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsFriend bool `protobuf:"varint,1,opt,name=isFriend,proto3" json:"isFriend,omitempty"`
+	// Oldest first, the last revision is the current text
+	Revisions []*CommentRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *IsFriendResponse) Reset() {
-	*x = IsFriendResponse{}
+func (x *GetCommentHistoryResponse) Reset() {
+	*x = GetCommentHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2539,13 +2906,13 @@ func (x *IsFriendResponse) Reset() {
 	}
 }
 
-func (x *IsFriendResponse) String() string {
+func (x *GetCommentHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsFriendResponse) ProtoMessage() {}
+func (*GetCommentHistoryResponse) ProtoMessage() {}
 
-func (x *IsFriendResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCommentHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2557,28 +2924,29 @@ func (x *IsFriendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsFriendResponse.ProtoReflect.Descriptor instead.
-func (*IsFriendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *IsFriendResponse) GetIsFriend() bool {
+func (x *GetCommentHistoryResponse) GetRevisions() []*CommentRevision {
 	if x != nil {
-		return x.IsFriend
+		return x.Revisions
 	}
-	return false
+	return nil
 }
 
-type CountReviewsRequest struct {
+type SoftDeleteCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=commentId,proto3" json:"commentId,omitempty"`
 }
 
-func (x *CountReviewsRequest) Reset() {
-	*x = CountReviewsRequest{}
+func (x *SoftDeleteCommentRequest) Reset() {
+	*x = SoftDeleteCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2586,13 +2954,13 @@ func (x *CountReviewsRequest) Reset() {
 	}
 }
 
-func (x *CountReviewsRequest) String() string {
+func (x *SoftDeleteCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountReviewsRequest) ProtoMessage() {}
+func (*SoftDeleteCommentRequest) ProtoMessage() {}
 
-func (x *CountReviewsRequest) ProtoReflect() protoreflect.Message {
+func (x *SoftDeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2604,28 +2972,33 @@ func (x *CountReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountReviewsRequest.ProtoReflect.Descriptor instead.
-func (*CountReviewsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SoftDeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*SoftDeleteCommentRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CountReviewsRequest) GetUserId() string {
+func (x *SoftDeleteCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type CountReviewsResponse struct {
+func (x *SoftDeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type SoftDeleteCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CountReviewsResponse) Reset() {
-	*x = CountReviewsResponse{}
+func (x *SoftDeleteCommentResponse) Reset() {
+	*x = SoftDeleteCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2633,13 +3006,13 @@ func (x *CountReviewsResponse) Reset() {
 	}
 }
 
-func (x *CountReviewsResponse) String() string {
+func (x *SoftDeleteCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountReviewsResponse) ProtoMessage() {}
+func (*SoftDeleteCommentResponse) ProtoMessage() {}
 
-func (x *CountReviewsResponse) ProtoReflect() protoreflect.Message {
+func (x *SoftDeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2651,28 +3024,22 @@ func (x *CountReviewsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountReviewsResponse.ProtoReflect.Descriptor instead.
-func (*CountReviewsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SoftDeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*SoftDeleteCommentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CountReviewsResponse) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type CreateTagRequest struct {
+type RestoreCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=commentId,proto3" json:"commentId,omitempty"`
 }
 
-func (x *CreateTagRequest) Reset() {
-	*x = CreateTagRequest{}
+func (x *RestoreCommentRequest) Reset() {
+	*x = RestoreCommentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2680,13 +3047,13 @@ func (x *CreateTagRequest) Reset() {
 	}
 }
 
-func (x *CreateTagRequest) String() string {
+func (x *RestoreCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTagRequest) ProtoMessage() {}
+func (*RestoreCommentRequest) ProtoMessage() {}
 
-func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2698,26 +3065,33 @@ func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
-func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreCommentRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCommentRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreateTagRequest) GetId() string {
+func (x *RestoreCommentRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type CreateTagResponse struct {
+func (x *RestoreCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type RestoreCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateTagResponse) Reset() {
-	*x = CreateTagResponse{}
+func (x *RestoreCommentResponse) Reset() {
+	*x = RestoreCommentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2725,13 +3099,13 @@ func (x *CreateTagResponse) Reset() {
 	}
 }
 
-func (x *CreateTagResponse) String() string {
+func (x *RestoreCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTagResponse) ProtoMessage() {}
+func (*RestoreCommentResponse) ProtoMessage() {}
 
-func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2743,23 +3117,23 @@ func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
-func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreCommentResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCommentResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{52}
 }
 
-type ConnectTagsRequest struct {
+type GetProfileCommentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id1   string  `protobuf:"bytes,1,opt,name=id1,proto3" json:"id1,omitempty"`
-	Id2   string  `protobuf:"bytes,2,opt,name=id2,proto3" json:"id2,omitempty"`
-	Score float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *ConnectTagsRequest) Reset() {
-	*x = ConnectTagsRequest{}
+func (x *GetProfileCommentsRequest) Reset() {
+	*x = GetProfileCommentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2767,13 +3141,13 @@ func (x *ConnectTagsRequest) Reset() {
 	}
 }
 
-func (x *ConnectTagsRequest) String() string {
+func (x *GetProfileCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectTagsRequest) ProtoMessage() {}
+func (*GetProfileCommentsRequest) ProtoMessage() {}
 
-func (x *ConnectTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProfileCommentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2785,40 +3159,42 @@ func (x *ConnectTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectTagsRequest.ProtoReflect.Descriptor instead.
-func (*ConnectTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileCommentsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ConnectTagsRequest) GetId1() string {
+func (x *GetProfileCommentsRequest) GetUserId() string {
 	if x != nil {
-		return x.Id1
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ConnectTagsRequest) GetId2() string {
+func (x *GetProfileCommentsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id2
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *ConnectTagsRequest) GetScore() float32 {
+func (x *GetProfileCommentsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Score
+		return x.Offset
 	}
 	return 0
 }
 
-type ConnectTagsResponse struct {
+type GetProfileCommentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
 }
 
-func (x *ConnectTagsResponse) Reset() {
-	*x = ConnectTagsResponse{}
+func (x *GetProfileCommentsResponse) Reset() {
+	*x = GetProfileCommentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2826,13 +3202,13 @@ func (x *ConnectTagsResponse) Reset() {
 	}
 }
 
-func (x *ConnectTagsResponse) String() string {
+func (x *GetProfileCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectTagsResponse) ProtoMessage() {}
+func (*GetProfileCommentsResponse) ProtoMessage() {}
 
-func (x *ConnectTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProfileCommentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2844,22 +3220,32 @@ func (x *ConnectTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectTagsResponse.ProtoReflect.Descriptor instead.
-func (*ConnectTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProfileCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileCommentsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{54}
 }
 
-type DisconnectTagsRequest struct {
+func (x *GetProfileCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type GetFavouriteLandmarksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id1 string `protobuf:"bytes,1,opt,name=id1,proto3" json:"id1,omitempty"`
-	Id2 string `protobuf:"bytes,2,opt,name=id2,proto3" json:"id2,omitempty"`
+	UserId    string       `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit     int32        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Northeast *Coordinates `protobuf:"bytes,3,opt,name=northeast,proto3" json:"northeast,omitempty"`
+	Southeast *Coordinates `protobuf:"bytes,4,opt,name=southeast,proto3" json:"southeast,omitempty"`
+	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *DisconnectTagsRequest) Reset() {
-	*x = DisconnectTagsRequest{}
+func (x *GetFavouriteLandmarksRequest) Reset() {
+	*x = GetFavouriteLandmarksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2867,13 +3253,13 @@ func (x *DisconnectTagsRequest) Reset() {
 	}
 }
 
-func (x *DisconnectTagsRequest) String() string {
+func (x *GetFavouriteLandmarksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectTagsRequest) ProtoMessage() {}
+func (*GetFavouriteLandmarksRequest) ProtoMessage() {}
 
-func (x *DisconnectTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFavouriteLandmarksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2885,33 +3271,56 @@ func (x *DisconnectTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectTagsRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavouriteLandmarksRequest.ProtoReflect.Descriptor instead.
+func (*GetFavouriteLandmarksRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DisconnectTagsRequest) GetId1() string {
+func (x *GetFavouriteLandmarksRequest) GetUserId() string {
 	if x != nil {
-		return x.Id1
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *DisconnectTagsRequest) GetId2() string {
+func (x *GetFavouriteLandmarksRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id2
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type DisconnectTagsResponse struct {
+func (x *GetFavouriteLandmarksRequest) GetNortheast() *Coordinates {
+	if x != nil {
+		return x.Northeast
+	}
+	return nil
+}
+
+func (x *GetFavouriteLandmarksRequest) GetSoutheast() *Coordinates {
+	if x != nil {
+		return x.Southeast
+	}
+	return nil
+}
+
+func (x *GetFavouriteLandmarksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetFavouriteLandmarksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *DisconnectTagsResponse) Reset() {
-	*x = DisconnectTagsResponse{}
+func (x *GetFavouriteLandmarksResponse) Reset() {
+	*x = GetFavouriteLandmarksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2919,13 +3328,13 @@ func (x *DisconnectTagsResponse) Reset() {
 	}
 }
 
-func (x *DisconnectTagsResponse) String() string {
+func (x *GetFavouriteLandmarksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectTagsResponse) ProtoMessage() {}
+func (*GetFavouriteLandmarksResponse) ProtoMessage() {}
 
-func (x *DisconnectTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFavouriteLandmarksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,21 +3346,28 @@ func (x *DisconnectTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectTagsResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFavouriteLandmarksResponse.ProtoReflect.Descriptor instead.
+func (*GetFavouriteLandmarksResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{56}
 }
 
-type DeleteTagRequest struct {
+func (x *GetFavouriteLandmarksResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetLikesAmountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *DeleteTagRequest) Reset() {
-	*x = DeleteTagRequest{}
+func (x *GetLikesAmountRequest) Reset() {
+	*x = GetLikesAmountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2959,13 +3375,13 @@ func (x *DeleteTagRequest) Reset() {
 	}
 }
 
-func (x *DeleteTagRequest) String() string {
+func (x *GetLikesAmountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagRequest) ProtoMessage() {}
+func (*GetLikesAmountRequest) ProtoMessage() {}
 
-func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLikesAmountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2977,26 +3393,28 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLikesAmountRequest.ProtoReflect.Descriptor instead.
+func (*GetLikesAmountRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DeleteTagRequest) GetId() string {
+func (x *GetLikesAmountRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteTagResponse struct {
+type GetLikesAmountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *DeleteTagResponse) Reset() {
-	*x = DeleteTagResponse{}
+func (x *GetLikesAmountResponse) Reset() {
+	*x = GetLikesAmountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3004,13 +3422,13 @@ func (x *DeleteTagResponse) Reset() {
 	}
 }
 
-func (x *DeleteTagResponse) String() string {
+func (x *GetLikesAmountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagResponse) ProtoMessage() {}
+func (*GetLikesAmountResponse) ProtoMessage() {}
 
-func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLikesAmountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3022,23 +3440,28 @@ func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLikesAmountResponse.ProtoReflect.Descriptor instead.
+func (*GetLikesAmountResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{58}
 }
 
-type AddLandmarkTagRequest struct {
+func (x *GetLikesAmountResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUserTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string  `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	TagId      string  `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
-	Score      float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *AddLandmarkTagRequest) Reset() {
-	*x = AddLandmarkTagRequest{}
+func (x *GetUserTagsRequest) Reset() {
+	*x = GetUserTagsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3046,13 +3469,13 @@ func (x *AddLandmarkTagRequest) Reset() {
 	}
 }
 
-func (x *AddLandmarkTagRequest) String() string {
+func (x *GetUserTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLandmarkTagRequest) ProtoMessage() {}
+func (*GetUserTagsRequest) ProtoMessage() {}
 
-func (x *AddLandmarkTagRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3064,40 +3487,30 @@ func (x *AddLandmarkTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLandmarkTagRequest.ProtoReflect.Descriptor instead.
-func (*AddLandmarkTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTagsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AddLandmarkTagRequest) GetLandmarkId() string {
-	if x != nil {
-		return x.LandmarkId
-	}
-	return ""
-}
-
-func (x *AddLandmarkTagRequest) GetTagId() string {
+func (x *GetUserTagsRequest) GetUserId() string {
 	if x != nil {
-		return x.TagId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddLandmarkTagRequest) GetScore() float32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-type AddLandmarkTagResponse struct {
+type GetUserTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Incremented on every change of the user's tags
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *AddLandmarkTagResponse) Reset() {
-	*x = AddLandmarkTagResponse{}
+func (x *GetUserTagsResponse) Reset() {
+	*x = GetUserTagsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3105,13 +3518,13 @@ func (x *AddLandmarkTagResponse) Reset() {
 	}
 }
 
-func (x *AddLandmarkTagResponse) String() string {
+func (x *GetUserTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLandmarkTagResponse) ProtoMessage() {}
+func (*GetUserTagsResponse) ProtoMessage() {}
 
-func (x *AddLandmarkTagResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3123,22 +3536,36 @@ func (x *AddLandmarkTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLandmarkTagResponse.ProtoReflect.Descriptor instead.
-func (*AddLandmarkTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTagsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{60}
 }
 
-type RemoveLandmarkTagRequest struct {
+func (x *GetUserTagsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetUserTagsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetFeaturesTopicsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	TagId      string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *RemoveLandmarkTagRequest) Reset() {
-	*x = RemoveLandmarkTagRequest{}
+func (x *GetFeaturesTopicsRequest) Reset() {
+	*x = GetFeaturesTopicsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3146,13 +3573,13 @@ func (x *RemoveLandmarkTagRequest) Reset() {
 	}
 }
 
-func (x *RemoveLandmarkTagRequest) String() string {
+func (x *GetFeaturesTopicsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLandmarkTagRequest) ProtoMessage() {}
+func (*GetFeaturesTopicsRequest) ProtoMessage() {}
 
-func (x *RemoveLandmarkTagRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFeaturesTopicsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3164,33 +3591,37 @@ func (x *RemoveLandmarkTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLandmarkTagRequest.ProtoReflect.Descriptor instead.
-func (*RemoveLandmarkTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeaturesTopicsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeaturesTopicsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *RemoveLandmarkTagRequest) GetLandmarkId() string {
+func (x *GetFeaturesTopicsRequest) GetUserId() string {
 	if x != nil {
-		return x.LandmarkId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RemoveLandmarkTagRequest) GetTagId() string {
+func (x *GetFeaturesTopicsRequest) GetAmount() int32 {
 	if x != nil {
-		return x.TagId
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-type RemoveLandmarkTagResponse struct {
+type FeaturedTopic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Illustration string `protobuf:"bytes,3,opt,name=illustration,proto3" json:"illustration,omitempty"`
 }
 
-func (x *RemoveLandmarkTagResponse) Reset() {
-	*x = RemoveLandmarkTagResponse{}
+func (x *FeaturedTopic) Reset() {
+	*x = FeaturedTopic{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3198,13 +3629,13 @@ func (x *RemoveLandmarkTagResponse) Reset() {
 	}
 }
 
-func (x *RemoveLandmarkTagResponse) String() string {
+func (x *FeaturedTopic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLandmarkTagResponse) ProtoMessage() {}
+func (*FeaturedTopic) ProtoMessage() {}
 
-func (x *RemoveLandmarkTagResponse) ProtoReflect() protoreflect.Message {
+func (x *FeaturedTopic) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,21 +3647,42 @@ func (x *RemoveLandmarkTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLandmarkTagResponse.ProtoReflect.Descriptor instead.
-func (*RemoveLandmarkTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeaturedTopic.ProtoReflect.Descriptor instead.
+func (*FeaturedTopic) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{62}
 }
 
-type GetLandmarkTagsRequest struct {
+func (x *FeaturedTopic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeaturedTopic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeaturedTopic) GetIllustration() string {
+	if x != nil {
+		return x.Illustration
+	}
+	return ""
+}
+
+type GetFeaturedTopicsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	Topics []*FeaturedTopic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
-func (x *GetLandmarkTagsRequest) Reset() {
-	*x = GetLandmarkTagsRequest{}
+func (x *GetFeaturedTopicsResponse) Reset() {
+	*x = GetFeaturedTopicsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3238,13 +3690,13 @@ func (x *GetLandmarkTagsRequest) Reset() {
 	}
 }
 
-func (x *GetLandmarkTagsRequest) String() string {
+func (x *GetFeaturedTopicsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarkTagsRequest) ProtoMessage() {}
+func (*GetFeaturedTopicsResponse) ProtoMessage() {}
 
-func (x *GetLandmarkTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFeaturedTopicsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3256,28 +3708,29 @@ func (x *GetLandmarkTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarkTagsRequest.ProtoReflect.Descriptor instead.
-func (*GetLandmarkTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeaturedTopicsResponse.ProtoReflect.Descriptor instead.
+func (*GetFeaturedTopicsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetLandmarkTagsRequest) GetLandmarkId() string {
+func (x *GetFeaturedTopicsResponse) GetTopics() []*FeaturedTopic {
 	if x != nil {
-		return x.LandmarkId
+		return x.Topics
 	}
-	return ""
+	return nil
 }
 
-type GetLandmarkTagsResponse struct {
+type AddFriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (x *GetLandmarkTagsResponse) Reset() {
-	*x = GetLandmarkTagsResponse{}
+func (x *AddFriendRequest) Reset() {
+	*x = AddFriendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3285,13 +3738,13 @@ func (x *GetLandmarkTagsResponse) Reset() {
 	}
 }
 
-func (x *GetLandmarkTagsResponse) String() string {
+func (x *AddFriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarkTagsResponse) ProtoMessage() {}
+func (*AddFriendRequest) ProtoMessage() {}
 
-func (x *GetLandmarkTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *AddFriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3303,28 +3756,33 @@ func (x *GetLandmarkTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarkTagsResponse.ProtoReflect.Descriptor instead.
-func (*GetLandmarkTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddFriendRequest.ProtoReflect.Descriptor instead.
+func (*AddFriendRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetLandmarkTagsResponse) GetIds() []string {
+func (x *AddFriendRequest) GetSender() string {
 	if x != nil {
-		return x.Ids
+		return x.Sender
 	}
-	return nil
+	return ""
 }
 
-type GetConnectedTagsRequest struct {
+func (x *AddFriendRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+type AddFriendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TagId string `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
 }
 
-func (x *GetConnectedTagsRequest) Reset() {
-	*x = GetConnectedTagsRequest{}
+func (x *AddFriendResponse) Reset() {
+	*x = AddFriendResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3332,13 +3790,13 @@ func (x *GetConnectedTagsRequest) Reset() {
 	}
 }
 
-func (x *GetConnectedTagsRequest) String() string {
+func (x *AddFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConnectedTagsRequest) ProtoMessage() {}
+func (*AddFriendResponse) ProtoMessage() {}
 
-func (x *GetConnectedTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *AddFriendResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3350,29 +3808,22 @@ func (x *GetConnectedTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConnectedTagsRequest.ProtoReflect.Descriptor instead.
-func (*GetConnectedTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddFriendResponse.ProtoReflect.Descriptor instead.
+func (*AddFriendResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetConnectedTagsRequest) GetTagId() string {
-	if x != nil {
-		return x.TagId
-	}
-	return ""
-}
-
-type Tag struct {
+type DeleteFriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (x *Tag) Reset() {
-	*x = Tag{}
+func (x *DeleteFriendRequest) Reset() {
+	*x = DeleteFriendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3380,13 +3831,13 @@ func (x *Tag) Reset() {
 	}
 }
 
-func (x *Tag) String() string {
+func (x *DeleteFriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tag) ProtoMessage() {}
+func (*DeleteFriendRequest) ProtoMessage() {}
 
-func (x *Tag) ProtoReflect() protoreflect.Message {
+func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3398,35 +3849,33 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
-func (*Tag) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFriendRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFriendRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Tag) GetId() string {
+func (x *DeleteFriendRequest) GetSender() string {
 	if x != nil {
-		return x.Id
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *Tag) GetScore() float32 {
+func (x *DeleteFriendRequest) GetReceiver() string {
 	if x != nil {
-		return x.Score
+		return x.Receiver
 	}
-	return 0
+	return ""
 }
 
-type GetConnectedTagsResponse struct {
+type DeleteFriendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *GetConnectedTagsResponse) Reset() {
-	*x = GetConnectedTagsResponse{}
+func (x *DeleteFriendResponse) Reset() {
+	*x = DeleteFriendResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3434,13 +3883,13 @@ func (x *GetConnectedTagsResponse) Reset() {
 	}
 }
 
-func (x *GetConnectedTagsResponse) String() string {
+func (x *DeleteFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConnectedTagsResponse) ProtoMessage() {}
+func (*DeleteFriendResponse) ProtoMessage() {}
 
-func (x *GetConnectedTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3452,29 +3901,21 @@ func (x *GetConnectedTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConnectedTagsResponse.ProtoReflect.Descriptor instead.
-func (*GetConnectedTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFriendResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFriendResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetConnectedTagsResponse) GetTags() []*Tag {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-type SetUserTagRequest struct {
+type GetFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	TagId  string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
 }
 
-func (x *SetUserTagRequest) Reset() {
-	*x = SetUserTagRequest{}
+func (x *GetFriendsRequest) Reset() {
+	*x = GetFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3482,13 +3923,13 @@ func (x *SetUserTagRequest) Reset() {
 	}
 }
 
-func (x *SetUserTagRequest) String() string {
+func (x *GetFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserTagRequest) ProtoMessage() {}
+func (*GetFriendsRequest) ProtoMessage() {}
 
-func (x *SetUserTagRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3500,33 +3941,28 @@ func (x *SetUserTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserTagRequest.ProtoReflect.Descriptor instead.
-func (*SetUserTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SetUserTagRequest) GetUserId() string {
+func (x *GetFriendsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SetUserTagRequest) GetTagId() string {
-	if x != nil {
-		return x.TagId
-	}
-	return ""
-}
-
-type SetUserTagResponse struct {
+type GetFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *SetUserTagResponse) Reset() {
-	*x = SetUserTagResponse{}
+func (x *GetFriendsResponse) Reset() {
+	*x = GetFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,13 +3970,13 @@ func (x *SetUserTagResponse) Reset() {
 	}
 }
 
-func (x *SetUserTagResponse) String() string {
+func (x *GetFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserTagResponse) ProtoMessage() {}
+func (*GetFriendsResponse) ProtoMessage() {}
 
-func (x *SetUserTagResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3552,22 +3988,28 @@ func (x *SetUserTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserTagResponse.ProtoReflect.Descriptor instead.
-func (*SetUserTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFriendsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{69}
 }
 
-type DeleteUserTagRequest struct {
+func (x *GetFriendsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CountFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	TagId  string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
 }
 
-func (x *DeleteUserTagRequest) Reset() {
-	*x = DeleteUserTagRequest{}
+func (x *CountFriendsRequest) Reset() {
+	*x = CountFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3575,13 +4017,13 @@ func (x *DeleteUserTagRequest) Reset() {
 	}
 }
 
-func (x *DeleteUserTagRequest) String() string {
+func (x *CountFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserTagRequest) ProtoMessage() {}
+func (*CountFriendsRequest) ProtoMessage() {}
 
-func (x *DeleteUserTagRequest) ProtoReflect() protoreflect.Message {
+func (x *CountFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3593,33 +4035,28 @@ func (x *DeleteUserTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountFriendsRequest.ProtoReflect.Descriptor instead.
+func (*CountFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteUserTagRequest) GetUserId() string {
+func (x *CountFriendsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteUserTagRequest) GetTagId() string {
-	if x != nil {
-		return x.TagId
-	}
-	return ""
-}
-
-type DeleteUserTagResponse struct {
+type CountFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *DeleteUserTagResponse) Reset() {
-	*x = DeleteUserTagResponse{}
+func (x *CountFriendsResponse) Reset() {
+	*x = CountFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3627,13 +4064,13 @@ func (x *DeleteUserTagResponse) Reset() {
 	}
 }
 
-func (x *DeleteUserTagResponse) String() string {
+func (x *CountFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserTagResponse) ProtoMessage() {}
+func (*CountFriendsResponse) ProtoMessage() {}
 
-func (x *DeleteUserTagResponse) ProtoReflect() protoreflect.Message {
+func (x *CountFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3645,25 +4082,29 @@ func (x *DeleteUserTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserTagResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountFriendsResponse.ProtoReflect.Descriptor instead.
+func (*CountFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{71}
 }
 
-type GetLandmarksByTagRequest struct {
+func (x *CountFriendsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type IsFriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TagId     string       `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
-	Limit     int32        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset    int32        `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Northeast *Coordinates `protobuf:"bytes,4,opt,name=northeast,proto3" json:"northeast,omitempty"`
-	Southeast *Coordinates `protobuf:"bytes,5,opt,name=southeast,proto3" json:"southeast,omitempty"`
+	User1 string `protobuf:"bytes,1,opt,name=user1,proto3" json:"user1,omitempty"`
+	User2 string `protobuf:"bytes,2,opt,name=user2,proto3" json:"user2,omitempty"`
 }
 
-func (x *GetLandmarksByTagRequest) Reset() {
-	*x = GetLandmarksByTagRequest{}
+func (x *IsFriendRequest) Reset() {
+	*x = IsFriendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3671,13 +4112,13 @@ func (x *GetLandmarksByTagRequest) Reset() {
 	}
 }
 
-func (x *GetLandmarksByTagRequest) String() string {
+func (x *IsFriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarksByTagRequest) ProtoMessage() {}
+func (*IsFriendRequest) ProtoMessage() {}
 
-func (x *GetLandmarksByTagRequest) ProtoReflect() protoreflect.Message {
+func (x *IsFriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3689,56 +4130,35 @@ func (x *GetLandmarksByTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarksByTagRequest.ProtoReflect.Descriptor instead.
-func (*GetLandmarksByTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsFriendRequest.ProtoReflect.Descriptor instead.
+func (*IsFriendRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetLandmarksByTagRequest) GetTagId() string {
+func (x *IsFriendRequest) GetUser1() string {
 	if x != nil {
-		return x.TagId
+		return x.User1
 	}
 	return ""
 }
 
-func (x *GetLandmarksByTagRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetLandmarksByTagRequest) GetOffset() int32 {
+func (x *IsFriendRequest) GetUser2() string {
 	if x != nil {
-		return x.Offset
+		return x.User2
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLandmarksByTagRequest) GetNortheast() *Coordinates {
-	if x != nil {
-		return x.Northeast
-	}
-	return nil
-}
-
-func (x *GetLandmarksByTagRequest) GetSoutheast() *Coordinates {
-	if x != nil {
-		return x.Southeast
-	}
-	return nil
-}
-
-type GetLandmarksByTagResponse struct {
+type IsFriendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	IsFriend bool `protobuf:"varint,1,opt,name=isFriend,proto3" json:"isFriend,omitempty"`
 }
 
-func (x *GetLandmarksByTagResponse) Reset() {
-	*x = GetLandmarksByTagResponse{}
+func (x *IsFriendResponse) Reset() {
+	*x = IsFriendResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3746,13 +4166,13 @@ func (x *GetLandmarksByTagResponse) Reset() {
 	}
 }
 
-func (x *GetLandmarksByTagResponse) String() string {
+func (x *IsFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarksByTagResponse) ProtoMessage() {}
+func (*IsFriendResponse) ProtoMessage() {}
 
-func (x *GetLandmarksByTagResponse) ProtoReflect() protoreflect.Message {
+func (x *IsFriendResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3764,33 +4184,29 @@ func (x *GetLandmarksByTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarksByTagResponse.ProtoReflect.Descriptor instead.
-func (*GetLandmarksByTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsFriendResponse.ProtoReflect.Descriptor instead.
+func (*IsFriendResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetLandmarksByTagResponse) GetIds() []string {
+func (x *IsFriendResponse) GetIsFriend() bool {
 	if x != nil {
-		return x.Ids
+		return x.IsFriend
 	}
-	return nil
+	return false
 }
 
-type GetLandmarksFilteredRequest struct {
+type MutualFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Include   []string     `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
-	Exclude   []string     `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
-	Northeast *Coordinates `protobuf:"bytes,3,opt,name=northeast,proto3" json:"northeast,omitempty"`
-	Southeast *Coordinates `protobuf:"bytes,4,opt,name=southeast,proto3" json:"southeast,omitempty"`
-	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit     int32        `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	User1 string `protobuf:"bytes,1,opt,name=user1,proto3" json:"user1,omitempty"`
+	User2 string `protobuf:"bytes,2,opt,name=user2,proto3" json:"user2,omitempty"`
 }
 
-func (x *GetLandmarksFilteredRequest) Reset() {
-	*x = GetLandmarksFilteredRequest{}
+func (x *MutualFriendsRequest) Reset() {
+	*x = MutualFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3798,13 +4214,13 @@ func (x *GetLandmarksFilteredRequest) Reset() {
 	}
 }
 
-func (x *GetLandmarksFilteredRequest) String() string {
+func (x *MutualFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarksFilteredRequest) ProtoMessage() {}
+func (*MutualFriendsRequest) ProtoMessage() {}
 
-func (x *GetLandmarksFilteredRequest) ProtoReflect() protoreflect.Message {
+func (x *MutualFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3816,54 +4232,26 @@ func (x *GetLandmarksFilteredRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarksFilteredRequest.ProtoReflect.Descriptor instead.
-func (*GetLandmarksFilteredRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MutualFriendsRequest.ProtoReflect.Descriptor instead.
+func (*MutualFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetLandmarksFilteredRequest) GetInclude() []string {
-	if x != nil {
-		return x.Include
-	}
-	return nil
-}
-
-func (x *GetLandmarksFilteredRequest) GetExclude() []string {
-	if x != nil {
-		return x.Exclude
-	}
-	return nil
-}
-
-func (x *GetLandmarksFilteredRequest) GetNortheast() *Coordinates {
-	if x != nil {
-		return x.Northeast
-	}
-	return nil
-}
-
-func (x *GetLandmarksFilteredRequest) GetSoutheast() *Coordinates {
-	if x != nil {
-		return x.Southeast
-	}
-	return nil
-}
-
-func (x *GetLandmarksFilteredRequest) GetOffset() int32 {
+func (x *MutualFriendsRequest) GetUser1() string {
 	if x != nil {
-		return x.Offset
+		return x.User1
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLandmarksFilteredRequest) GetLimit() int32 {
+func (x *MutualFriendsRequest) GetUser2() string {
 	if x != nil {
-		return x.Limit
+		return x.User2
 	}
-	return 0
+	return ""
 }
 
-type GetLandmarksFilteredResponse struct {
+type MutualFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3871,8 +4259,8 @@ type GetLandmarksFilteredResponse struct {
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *GetLandmarksFilteredResponse) Reset() {
-	*x = GetLandmarksFilteredResponse{}
+func (x *MutualFriendsResponse) Reset() {
+	*x = MutualFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3880,13 +4268,13 @@ func (x *GetLandmarksFilteredResponse) Reset() {
 	}
 }
 
-func (x *GetLandmarksFilteredResponse) String() string {
+func (x *MutualFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarksFilteredResponse) ProtoMessage() {}
+func (*MutualFriendsResponse) ProtoMessage() {}
 
-func (x *GetLandmarksFilteredResponse) ProtoReflect() protoreflect.Message {
+func (x *MutualFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3898,29 +4286,29 @@ func (x *GetLandmarksFilteredResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarksFilteredResponse.ProtoReflect.Descriptor instead.
-func (*GetLandmarksFilteredResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MutualFriendsResponse.ProtoReflect.Descriptor instead.
+func (*MutualFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetLandmarksFilteredResponse) GetIds() []string {
+func (x *MutualFriendsResponse) GetIds() []string {
 	if x != nil {
 		return x.Ids
 	}
 	return nil
 }
 
-type UpdateLandmarkScoreRequest struct {
+type CountMutualFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Score int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	User1 string `protobuf:"bytes,1,opt,name=user1,proto3" json:"user1,omitempty"`
+	User2 string `protobuf:"bytes,2,opt,name=user2,proto3" json:"user2,omitempty"`
 }
 
-func (x *UpdateLandmarkScoreRequest) Reset() {
-	*x = UpdateLandmarkScoreRequest{}
+func (x *CountMutualFriendsRequest) Reset() {
+	*x = CountMutualFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3928,13 +4316,13 @@ func (x *UpdateLandmarkScoreRequest) Reset() {
 	}
 }
 
-func (x *UpdateLandmarkScoreRequest) String() string {
+func (x *CountMutualFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLandmarkScoreRequest) ProtoMessage() {}
+func (*CountMutualFriendsRequest) ProtoMessage() {}
 
-func (x *UpdateLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
+func (x *CountMutualFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3946,33 +4334,35 @@ func (x *UpdateLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLandmarkScoreRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLandmarkScoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountMutualFriendsRequest.ProtoReflect.Descriptor instead.
+func (*CountMutualFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateLandmarkScoreRequest) GetId() string {
+func (x *CountMutualFriendsRequest) GetUser1() string {
 	if x != nil {
-		return x.Id
+		return x.User1
 	}
 	return ""
 }
 
-func (x *UpdateLandmarkScoreRequest) GetScore() int32 {
+func (x *CountMutualFriendsRequest) GetUser2() string {
 	if x != nil {
-		return x.Score
+		return x.User2
 	}
-	return 0
+	return ""
 }
 
-type UpdateLandmarkScoreResponse struct {
+type CountMutualFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UpdateLandmarkScoreResponse) Reset() {
-	*x = UpdateLandmarkScoreResponse{}
+func (x *CountMutualFriendsResponse) Reset() {
+	*x = CountMutualFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3980,13 +4370,13 @@ func (x *UpdateLandmarkScoreResponse) Reset() {
 	}
 }
 
-func (x *UpdateLandmarkScoreResponse) String() string {
+func (x *CountMutualFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLandmarkScoreResponse) ProtoMessage() {}
+func (*CountMutualFriendsResponse) ProtoMessage() {}
 
-func (x *UpdateLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
+func (x *CountMutualFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3998,23 +4388,29 @@ func (x *UpdateLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLandmarkScoreResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLandmarkScoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountMutualFriendsResponse.ProtoReflect.Descriptor instead.
+func (*CountMutualFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{77}
 }
 
-type GetRecentFriendsFavouritesRequest struct {
+func (x *CountMutualFriendsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AreFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId     string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Candidates []string `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
 }
 
-func (x *GetRecentFriendsFavouritesRequest) Reset() {
-	*x = GetRecentFriendsFavouritesRequest{}
+func (x *AreFriendsRequest) Reset() {
+	*x = AreFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4022,13 +4418,13 @@ func (x *GetRecentFriendsFavouritesRequest) Reset() {
 	}
 }
 
-func (x *GetRecentFriendsFavouritesRequest) String() string {
+func (x *AreFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentFriendsFavouritesRequest) ProtoMessage() {}
+func (*AreFriendsRequest) ProtoMessage() {}
 
-func (x *GetRecentFriendsFavouritesRequest) ProtoReflect() protoreflect.Message {
+func (x *AreFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4040,44 +4436,36 @@ func (x *GetRecentFriendsFavouritesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentFriendsFavouritesRequest.ProtoReflect.Descriptor instead.
-func (*GetRecentFriendsFavouritesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AreFriendsRequest.ProtoReflect.Descriptor instead.
+func (*AreFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetRecentFriendsFavouritesRequest) GetUserId() string {
+func (x *AreFriendsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetRecentFriendsFavouritesRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetRecentFriendsFavouritesRequest) GetOffset() int32 {
+func (x *AreFriendsRequest) GetCandidates() []string {
 	if x != nil {
-		return x.Offset
+		return x.Candidates
 	}
-	return 0
+	return nil
 }
 
-type FriendLikedLandmark struct {
+type AreFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FriendId   string `protobuf:"bytes,1,opt,name=friendId,proto3" json:"friendId,omitempty"`
-	LandmarkId string `protobuf:"bytes,2,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	Timestamp  int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// In the order of candidates
+	IsFriend []bool `protobuf:"varint,1,rep,packed,name=isFriend,proto3" json:"isFriend,omitempty"`
 }
 
-func (x *FriendLikedLandmark) Reset() {
-	*x = FriendLikedLandmark{}
+func (x *AreFriendsResponse) Reset() {
+	*x = AreFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4085,13 +4473,13 @@ func (x *FriendLikedLandmark) Reset() {
 	}
 }
 
-func (x *FriendLikedLandmark) String() string {
+func (x *AreFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendLikedLandmark) ProtoMessage() {}
+func (*AreFriendsResponse) ProtoMessage() {}
 
-func (x *FriendLikedLandmark) ProtoReflect() protoreflect.Message {
+func (x *AreFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4103,42 +4491,32 @@ func (x *FriendLikedLandmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendLikedLandmark.ProtoReflect.Descriptor instead.
-func (*FriendLikedLandmark) Descriptor() ([]byte, []int) {
+// Deprecated: Use AreFriendsResponse.ProtoReflect.Descriptor instead.
+func (*AreFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *FriendLikedLandmark) GetFriendId() string {
-	if x != nil {
-		return x.FriendId
-	}
-	return ""
-}
-
-func (x *FriendLikedLandmark) GetLandmarkId() string {
-	if x != nil {
-		return x.LandmarkId
-	}
-	return ""
-}
-
-func (x *FriendLikedLandmark) GetTimestamp() int64 {
+func (x *AreFriendsResponse) GetIsFriend() []bool {
 	if x != nil {
-		return x.Timestamp
+		return x.IsFriend
 	}
-	return 0
+	return nil
 }
 
-type GetRecentFriendsFavouritesResponse struct {
+type FriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result []*FriendLikedLandmark `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Id        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    string             `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver  string             `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	State     FriendRequestState `protobuf:"varint,4,opt,name=state,proto3,enum=landmark.storage.FriendRequestState" json:"state,omitempty"`
+	Timestamp int64              `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetRecentFriendsFavouritesResponse) Reset() {
-	*x = GetRecentFriendsFavouritesResponse{}
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4146,13 +4524,13 @@ func (x *GetRecentFriendsFavouritesResponse) Reset() {
 	}
 }
 
-func (x *GetRecentFriendsFavouritesResponse) String() string {
+func (x *FriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentFriendsFavouritesResponse) ProtoMessage() {}
+func (*FriendRequest) ProtoMessage() {}
 
-func (x *GetRecentFriendsFavouritesResponse) ProtoReflect() protoreflect.Message {
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4164,29 +4542,57 @@ func (x *GetRecentFriendsFavouritesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentFriendsFavouritesResponse.ProtoReflect.Descriptor instead.
-func (*GetRecentFriendsFavouritesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetRecentFriendsFavouritesResponse) GetResult() []*FriendLikedLandmark {
+func (x *FriendRequest) GetId() string {
 	if x != nil {
-		return x.Result
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type IsReviewedRequest struct {
+func (x *FriendRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetState() FriendRequestState {
+	if x != nil {
+		return x.State
+	}
+	return FriendRequestState_FRIEND_REQUEST_STATE_UNSPECIFIED
+}
+
+func (x *FriendRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SendFriendRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	UserId     string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 }
 
-func (x *IsReviewedRequest) Reset() {
-	*x = IsReviewedRequest{}
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4194,13 +4600,13 @@ func (x *IsReviewedRequest) Reset() {
 	}
 }
 
-func (x *IsReviewedRequest) String() string {
+func (x *SendFriendRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsReviewedRequest) ProtoMessage() {}
+func (*SendFriendRequestRequest) ProtoMessage() {}
 
-func (x *IsReviewedRequest) ProtoReflect() protoreflect.Message {
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4212,35 +4618,35 @@ func (x *IsReviewedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsReviewedRequest.ProtoReflect.Descriptor instead.
-func (*IsReviewedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *IsReviewedRequest) GetLandmarkId() string {
+func (x *SendFriendRequestRequest) GetSender() string {
 	if x != nil {
-		return x.LandmarkId
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *IsReviewedRequest) GetUserId() string {
+func (x *SendFriendRequestRequest) GetReceiver() string {
 	if x != nil {
-		return x.UserId
+		return x.Receiver
 	}
 	return ""
 }
 
-type IsReviewedResponse struct {
+type SendFriendRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsReviewed bool `protobuf:"varint,1,opt,name=isReviewed,proto3" json:"isReviewed,omitempty"`
+	Request *FriendRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *IsReviewedResponse) Reset() {
-	*x = IsReviewedResponse{}
+func (x *SendFriendRequestResponse) Reset() {
+	*x = SendFriendRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4248,13 +4654,13 @@ func (x *IsReviewedResponse) Reset() {
 	}
 }
 
-func (x *IsReviewedResponse) String() string {
+func (x *SendFriendRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsReviewedResponse) ProtoMessage() {}
+func (*SendFriendRequestResponse) ProtoMessage() {}
 
-func (x *IsReviewedResponse) ProtoReflect() protoreflect.Message {
+func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4266,29 +4672,30 @@ func (x *IsReviewedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsReviewedResponse.ProtoReflect.Descriptor instead.
-func (*IsReviewedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *IsReviewedResponse) GetIsReviewed() bool {
+func (x *SendFriendRequestResponse) GetRequest() *FriendRequest {
 	if x != nil {
-		return x.IsReviewed
+		return x.Request
 	}
-	return false
+	return nil
 }
 
-type GetReviewRequest struct {
+type GetFriendRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	UserId     string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *GetReviewRequest) Reset() {
-	*x = GetReviewRequest{}
+func (x *GetFriendRequestsRequest) Reset() {
+	*x = GetFriendRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4296,13 +4703,13 @@ func (x *GetReviewRequest) Reset() {
 	}
 }
 
-func (x *GetReviewRequest) String() string {
+func (x *GetFriendRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewRequest) ProtoMessage() {}
+func (*GetFriendRequestsRequest) ProtoMessage() {}
 
-func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFriendRequestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4314,35 +4721,42 @@ func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
-func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFriendRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetReviewRequest) GetLandmarkId() string {
+func (x *GetFriendRequestsRequest) GetUserId() string {
 	if x != nil {
-		return x.LandmarkId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetReviewRequest) GetUserId() string {
+func (x *GetFriendRequestsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type GetReviewResponse struct {
+func (x *GetFriendRequestsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetFriendRequestsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Review *Comment `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	Requests []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *GetReviewResponse) Reset() {
-	*x = GetReviewResponse{}
+func (x *GetFriendRequestsResponse) Reset() {
+	*x = GetFriendRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4350,13 +4764,13 @@ func (x *GetReviewResponse) Reset() {
 	}
 }
 
-func (x *GetReviewResponse) String() string {
+func (x *GetFriendRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReviewResponse) ProtoMessage() {}
+func (*GetFriendRequestsResponse) ProtoMessage() {}
 
-func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFriendRequestsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4368,29 +4782,30 @@ func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
-func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFriendRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendRequestsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetReviewResponse) GetReview() *Comment {
+func (x *GetFriendRequestsResponse) GetRequests() []*FriendRequest {
 	if x != nil {
-		return x.Review
+		return x.Requests
 	}
 	return nil
 }
 
-type SetLandmarkScoreRequest struct {
+// Accept and decline are done by the receiver, cancel by the sender
+type RespondFriendRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string  `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	Score      float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *SetLandmarkScoreRequest) Reset() {
-	*x = SetLandmarkScoreRequest{}
+func (x *RespondFriendRequestRequest) Reset() {
+	*x = RespondFriendRequestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4398,13 +4813,13 @@ func (x *SetLandmarkScoreRequest) Reset() {
 	}
 }
 
-func (x *SetLandmarkScoreRequest) String() string {
+func (x *RespondFriendRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLandmarkScoreRequest) ProtoMessage() {}
+func (*RespondFriendRequestRequest) ProtoMessage() {}
 
-func (x *SetLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
+func (x *RespondFriendRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4416,33 +4831,33 @@ func (x *SetLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLandmarkScoreRequest.ProtoReflect.Descriptor instead.
-func (*SetLandmarkScoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*RespondFriendRequestRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SetLandmarkScoreRequest) GetLandmarkId() string {
+func (x *RespondFriendRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.LandmarkId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *SetLandmarkScoreRequest) GetScore() float32 {
+func (x *RespondFriendRequestRequest) GetUserId() string {
 	if x != nil {
-		return x.Score
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type SetLandmarkScoreResponse struct {
+type RespondFriendRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetLandmarkScoreResponse) Reset() {
-	*x = SetLandmarkScoreResponse{}
+func (x *RespondFriendRequestResponse) Reset() {
+	*x = RespondFriendRequestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4450,13 +4865,13 @@ func (x *SetLandmarkScoreResponse) Reset() {
 	}
 }
 
-func (x *SetLandmarkScoreResponse) String() string {
+func (x *RespondFriendRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLandmarkScoreResponse) ProtoMessage() {}
+func (*RespondFriendRequestResponse) ProtoMessage() {}
 
-func (x *SetLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
+func (x *RespondFriendRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4468,22 +4883,22 @@ func (x *SetLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLandmarkScoreResponse.ProtoReflect.Descriptor instead.
-func (*SetLandmarkScoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RespondFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*RespondFriendRequestResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{86}
 }
 
-type SetMultipleViewedRequest struct {
+type BlockUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	LandmarkIds []string `protobuf:"bytes,2,rep,name=landmarkIds,proto3" json:"landmarkIds,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	BlockedId string `protobuf:"bytes,2,opt,name=blockedId,proto3" json:"blockedId,omitempty"`
 }
 
-func (x *SetMultipleViewedRequest) Reset() {
-	*x = SetMultipleViewedRequest{}
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4491,13 +4906,13 @@ func (x *SetMultipleViewedRequest) Reset() {
 	}
 }
 
-func (x *SetMultipleViewedRequest) String() string {
+func (x *BlockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMultipleViewedRequest) ProtoMessage() {}
+func (*BlockUserRequest) ProtoMessage() {}
 
-func (x *SetMultipleViewedRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4509,33 +4924,33 @@ func (x *SetMultipleViewedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMultipleViewedRequest.ProtoReflect.Descriptor instead.
-func (*SetMultipleViewedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SetMultipleViewedRequest) GetUserId() string {
+func (x *BlockUserRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SetMultipleViewedRequest) GetLandmarkIds() []string {
+func (x *BlockUserRequest) GetBlockedId() string {
 	if x != nil {
-		return x.LandmarkIds
+		return x.BlockedId
 	}
-	return nil
+	return ""
 }
 
-type SetMultipleViewedResponse struct {
+type BlockUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetMultipleViewedResponse) Reset() {
-	*x = SetMultipleViewedResponse{}
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4543,13 +4958,13 @@ func (x *SetMultipleViewedResponse) Reset() {
 	}
 }
 
-func (x *SetMultipleViewedResponse) String() string {
+func (x *BlockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMultipleViewedResponse) ProtoMessage() {}
+func (*BlockUserResponse) ProtoMessage() {}
 
-func (x *SetMultipleViewedResponse) ProtoReflect() protoreflect.Message {
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4561,22 +4976,21 @@ func (x *SetMultipleViewedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMultipleViewedResponse.ProtoReflect.Descriptor instead.
-func (*SetMultipleViewedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{88}
 }
 
-type NotInterestedRequest struct {
+type GetBlockedUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	LandmarkId string `protobuf:"bytes,2,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *NotInterestedRequest) Reset() {
-	*x = NotInterestedRequest{}
+func (x *GetBlockedUsersRequest) Reset() {
+	*x = GetBlockedUsersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4584,13 +4998,13 @@ func (x *NotInterestedRequest) Reset() {
 	}
 }
 
-func (x *NotInterestedRequest) String() string {
+func (x *GetBlockedUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotInterestedRequest) ProtoMessage() {}
+func (*GetBlockedUsersRequest) ProtoMessage() {}
 
-func (x *NotInterestedRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4602,33 +5016,28 @@ func (x *NotInterestedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotInterestedRequest.ProtoReflect.Descriptor instead.
-func (*NotInterestedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *NotInterestedRequest) GetUserId() string {
+func (x *GetBlockedUsersRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *NotInterestedRequest) GetLandmarkId() string {
-	if x != nil {
-		return x.LandmarkId
-	}
-	return ""
-}
-
-type NotInterestedResponse struct {
+type GetBlockedUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *NotInterestedResponse) Reset() {
-	*x = NotInterestedResponse{}
+func (x *GetBlockedUsersResponse) Reset() {
+	*x = GetBlockedUsersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4636,13 +5045,13 @@ func (x *NotInterestedResponse) Reset() {
 	}
 }
 
-func (x *NotInterestedResponse) String() string {
+func (x *GetBlockedUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotInterestedResponse) ProtoMessage() {}
+func (*GetBlockedUsersResponse) ProtoMessage() {}
 
-func (x *NotInterestedResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4654,22 +5063,29 @@ func (x *NotInterestedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotInterestedResponse.ProtoReflect.Descriptor instead.
-func (*NotInterestedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{90}
 }
 
-type ChangeUserTagsRequest struct {
+func (x *GetBlockedUsersResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type IsBlockedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	User1 string `protobuf:"bytes,1,opt,name=user1,proto3" json:"user1,omitempty"`
+	User2 string `protobuf:"bytes,2,opt,name=user2,proto3" json:"user2,omitempty"`
 }
 
-func (x *ChangeUserTagsRequest) Reset() {
-	*x = ChangeUserTagsRequest{}
+func (x *IsBlockedRequest) Reset() {
+	*x = IsBlockedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4677,13 +5093,13 @@ func (x *ChangeUserTagsRequest) Reset() {
 	}
 }
 
-func (x *ChangeUserTagsRequest) String() string {
+func (x *IsBlockedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserTagsRequest) ProtoMessage() {}
+func (*IsBlockedRequest) ProtoMessage() {}
 
-func (x *ChangeUserTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *IsBlockedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4695,33 +5111,36 @@ func (x *ChangeUserTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserTagsRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsBlockedRequest.ProtoReflect.Descriptor instead.
+func (*IsBlockedRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ChangeUserTagsRequest) GetUserId() string {
+func (x *IsBlockedRequest) GetUser1() string {
 	if x != nil {
-		return x.UserId
+		return x.User1
 	}
 	return ""
 }
 
-func (x *ChangeUserTagsRequest) GetTags() []string {
+func (x *IsBlockedRequest) GetUser2() string {
 	if x != nil {
-		return x.Tags
+		return x.User2
 	}
-	return nil
+	return ""
 }
 
-type ChangeUserTagsResponse struct {
+type IsBlockedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// True if either user has blocked the other
+	IsBlocked bool `protobuf:"varint,1,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`
 }
 
-func (x *ChangeUserTagsResponse) Reset() {
-	*x = ChangeUserTagsResponse{}
+func (x *IsBlockedResponse) Reset() {
+	*x = IsBlockedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4729,13 +5148,13 @@ func (x *ChangeUserTagsResponse) Reset() {
 	}
 }
 
-func (x *ChangeUserTagsResponse) String() string {
+func (x *IsBlockedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserTagsResponse) ProtoMessage() {}
+func (*IsBlockedResponse) ProtoMessage() {}
 
-func (x *ChangeUserTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *IsBlockedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4747,21 +5166,29 @@ func (x *ChangeUserTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserTagsResponse.ProtoReflect.Descriptor instead.
-func (*ChangeUserTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsBlockedResponse.ProtoReflect.Descriptor instead.
+func (*IsBlockedResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{92}
 }
 
-type DeleteLandmarkRequest struct {
+func (x *IsBlockedResponse) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+type SuggestFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DeleteLandmarkRequest) Reset() {
-	*x = DeleteLandmarkRequest{}
+func (x *SuggestFriendsRequest) Reset() {
+	*x = SuggestFriendsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4769,13 +5196,13 @@ func (x *DeleteLandmarkRequest) Reset() {
 	}
 }
 
-func (x *DeleteLandmarkRequest) String() string {
+func (x *SuggestFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLandmarkRequest) ProtoMessage() {}
+func (*SuggestFriendsRequest) ProtoMessage() {}
 
-func (x *DeleteLandmarkRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4787,26 +5214,38 @@ func (x *DeleteLandmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLandmarkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLandmarkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestFriendsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteLandmarkRequest) GetLandmarkId() string {
+func (x *SuggestFriendsRequest) GetUserId() string {
 	if x != nil {
-		return x.LandmarkId
+		return x.UserId
 	}
 	return ""
 }
 
-type DeleteLandmarkResponse struct {
+func (x *SuggestFriendsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FriendSuggestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId        string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	MutualFriends []string `protobuf:"bytes,2,rep,name=mutualFriends,proto3" json:"mutualFriends,omitempty"`
+	SharedTags    []string `protobuf:"bytes,3,rep,name=sharedTags,proto3" json:"sharedTags,omitempty"`
+	Score         float32  `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *DeleteLandmarkResponse) Reset() {
-	*x = DeleteLandmarkResponse{}
+func (x *FriendSuggestion) Reset() {
+	*x = FriendSuggestion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4814,13 +5253,13 @@ func (x *DeleteLandmarkResponse) Reset() {
 	}
 }
 
-func (x *DeleteLandmarkResponse) String() string {
+func (x *FriendSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLandmarkResponse) ProtoMessage() {}
+func (*FriendSuggestion) ProtoMessage() {}
 
-func (x *DeleteLandmarkResponse) ProtoReflect() protoreflect.Message {
+func (x *FriendSuggestion) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4832,22 +5271,49 @@ func (x *DeleteLandmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLandmarkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLandmarkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FriendSuggestion.ProtoReflect.Descriptor instead.
+func (*FriendSuggestion) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{94}
 }
 
-type SetLandmarkCoordsRequest struct {
+func (x *FriendSuggestion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FriendSuggestion) GetMutualFriends() []string {
+	if x != nil {
+		return x.MutualFriends
+	}
+	return nil
+}
+
+func (x *FriendSuggestion) GetSharedTags() []string {
+	if x != nil {
+		return x.SharedTags
+	}
+	return nil
+}
+
+func (x *FriendSuggestion) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestFriendsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LandmarkId string       `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
-	Coords     *Coordinates `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	Suggestions []*FriendSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 }
 
-func (x *SetLandmarkCoordsRequest) Reset() {
-	*x = SetLandmarkCoordsRequest{}
+func (x *SuggestFriendsResponse) Reset() {
+	*x = SuggestFriendsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4855,13 +5321,13 @@ func (x *SetLandmarkCoordsRequest) Reset() {
 	}
 }
 
-func (x *SetLandmarkCoordsRequest) String() string {
+func (x *SuggestFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLandmarkCoordsRequest) ProtoMessage() {}
+func (*SuggestFriendsResponse) ProtoMessage() {}
 
-func (x *SetLandmarkCoordsRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestFriendsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4873,33 +5339,28 @@ func (x *SetLandmarkCoordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLandmarkCoordsRequest.ProtoReflect.Descriptor instead.
-func (*SetLandmarkCoordsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestFriendsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestFriendsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SetLandmarkCoordsRequest) GetLandmarkId() string {
-	if x != nil {
-		return x.LandmarkId
-	}
-	return ""
-}
-
-func (x *SetLandmarkCoordsRequest) GetCoords() *Coordinates {
+func (x *SuggestFriendsResponse) GetSuggestions() []*FriendSuggestion {
 	if x != nil {
-		return x.Coords
+		return x.Suggestions
 	}
 	return nil
 }
 
-type SetLandmarkCoordsResponse struct {
+type CountReviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *SetLandmarkCoordsResponse) Reset() {
-	*x = SetLandmarkCoordsResponse{}
+func (x *CountReviewsRequest) Reset() {
+	*x = CountReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4907,13 +5368,13 @@ func (x *SetLandmarkCoordsResponse) Reset() {
 	}
 }
 
-func (x *SetLandmarkCoordsResponse) String() string {
+func (x *CountReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLandmarkCoordsResponse) ProtoMessage() {}
+func (*CountReviewsRequest) ProtoMessage() {}
 
-func (x *SetLandmarkCoordsResponse) ProtoReflect() protoreflect.Message {
+func (x *CountReviewsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4925,24 +5386,28 @@ func (x *SetLandmarkCoordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLandmarkCoordsResponse.ProtoReflect.Descriptor instead.
-func (*SetLandmarkCoordsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountReviewsRequest.ProtoReflect.Descriptor instead.
+func (*CountReviewsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{96}
 }
 
-type TestGetFeedRequest struct {
+func (x *CountReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CountReviewsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string  `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Amount    int32   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Latitude  float32 `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32 `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TestGetFeedRequest) Reset() {
-	*x = TestGetFeedRequest{}
+func (x *CountReviewsResponse) Reset() {
+	*x = CountReviewsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4950,13 +5415,13 @@ func (x *TestGetFeedRequest) Reset() {
 	}
 }
 
-func (x *TestGetFeedRequest) String() string {
+func (x *CountReviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestGetFeedRequest) ProtoMessage() {}
+func (*CountReviewsResponse) ProtoMessage() {}
 
-func (x *TestGetFeedRequest) ProtoReflect() protoreflect.Message {
+func (x *CountReviewsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4968,49 +5433,28 @@ func (x *TestGetFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestGetFeedRequest.ProtoReflect.Descriptor instead.
-func (*TestGetFeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountReviewsResponse.ProtoReflect.Descriptor instead.
+func (*CountReviewsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *TestGetFeedRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *TestGetFeedRequest) GetAmount() int32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *TestGetFeedRequest) GetLatitude() float32 {
-	if x != nil {
-		return x.Latitude
-	}
-	return 0
-}
-
-func (x *TestGetFeedRequest) GetLongitude() float32 {
+func (x *CountReviewsResponse) GetCount() int32 {
 	if x != nil {
-		return x.Longitude
+		return x.Count
 	}
 	return 0
 }
 
-type TestGetFeedResponse struct {
+type CreateTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Feed []string `protobuf:"bytes,1,rep,name=feed,proto3" json:"feed,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TestGetFeedResponse) Reset() {
-	*x = TestGetFeedResponse{}
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5018,13 +5462,13 @@ func (x *TestGetFeedResponse) Reset() {
 	}
 }
 
-func (x *TestGetFeedResponse) String() string {
+func (x *CreateTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestGetFeedResponse) ProtoMessage() {}
+func (*CreateTagRequest) ProtoMessage() {}
 
-func (x *TestGetFeedResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5036,29 +5480,26 @@ func (x *TestGetFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestGetFeedResponse.ProtoReflect.Descriptor instead.
-func (*TestGetFeedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *TestGetFeedResponse) GetFeed() []string {
+func (x *CreateTagRequest) GetId() string {
 	if x != nil {
-		return x.Feed
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type SetNodeNameRequest struct {
+type CreateTagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SetNodeNameRequest) Reset() {
-	*x = SetNodeNameRequest{}
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5066,13 +5507,13 @@ func (x *SetNodeNameRequest) Reset() {
 	}
 }
 
-func (x *SetNodeNameRequest) String() string {
+func (x *CreateTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNodeNameRequest) ProtoMessage() {}
+func (*CreateTagResponse) ProtoMessage() {}
 
-func (x *SetNodeNameRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5084,33 +5525,23 @@ func (x *SetNodeNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNodeNameRequest.ProtoReflect.Descriptor instead.
-func (*SetNodeNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *SetNodeNameRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SetNodeNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type SetNodeNameResponse struct {
+type ConnectTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id1   string  `protobuf:"bytes,1,opt,name=id1,proto3" json:"id1,omitempty"`
+	Id2   string  `protobuf:"bytes,2,opt,name=id2,proto3" json:"id2,omitempty"`
+	Score float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *SetNodeNameResponse) Reset() {
-	*x = SetNodeNameResponse{}
+func (x *ConnectTagsRequest) Reset() {
+	*x = ConnectTagsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5118,13 +5549,13 @@ func (x *SetNodeNameResponse) Reset() {
 	}
 }
 
-func (x *SetNodeNameResponse) String() string {
+func (x *ConnectTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNodeNameResponse) ProtoMessage() {}
+func (*ConnectTagsRequest) ProtoMessage() {}
 
-func (x *SetNodeNameResponse) ProtoReflect() protoreflect.Message {
+func (x *ConnectTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5136,23 +5567,40 @@ func (x *SetNodeNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNodeNameResponse.ProtoReflect.Descriptor instead.
-func (*SetNodeNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectTagsRequest.ProtoReflect.Descriptor instead.
+func (*ConnectTagsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{100}
 }
 
-type GetSimilarPlacesRequest struct {
+func (x *ConnectTagsRequest) GetId1() string {
+	if x != nil {
+		return x.Id1
+	}
+	return ""
+}
+
+func (x *ConnectTagsRequest) GetId2() string {
+	if x != nil {
+		return x.Id2
+	}
+	return ""
+}
+
+func (x *ConnectTagsRequest) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ConnectTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ids    []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	Offset int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetSimilarPlacesRequest) Reset() {
-	*x = GetSimilarPlacesRequest{}
+func (x *ConnectTagsResponse) Reset() {
+	*x = ConnectTagsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5160,13 +5608,13 @@ func (x *GetSimilarPlacesRequest) Reset() {
 	}
 }
 
-func (x *GetSimilarPlacesRequest) String() string {
+func (x *ConnectTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimilarPlacesRequest) ProtoMessage() {}
+func (*ConnectTagsResponse) ProtoMessage() {}
 
-func (x *GetSimilarPlacesRequest) ProtoReflect() protoreflect.Message {
+func (x *ConnectTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5178,42 +5626,22 @@ func (x *GetSimilarPlacesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimilarPlacesRequest.ProtoReflect.Descriptor instead.
-func (*GetSimilarPlacesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectTagsResponse.ProtoReflect.Descriptor instead.
+func (*ConnectTagsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetSimilarPlacesRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-func (x *GetSimilarPlacesRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *GetSimilarPlacesRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type GetSimilarPlacesResponse struct {
+type DisconnectTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Id1 string `protobuf:"bytes,1,opt,name=id1,proto3" json:"id1,omitempty"`
+	Id2 string `protobuf:"bytes,2,opt,name=id2,proto3" json:"id2,omitempty"`
 }
 
-func (x *GetSimilarPlacesResponse) Reset() {
-	*x = GetSimilarPlacesResponse{}
+func (x *DisconnectTagsRequest) Reset() {
+	*x = DisconnectTagsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5221,13 +5649,13 @@ func (x *GetSimilarPlacesResponse) Reset() {
 	}
 }
 
-func (x *GetSimilarPlacesResponse) String() string {
+func (x *DisconnectTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimilarPlacesResponse) ProtoMessage() {}
+func (*DisconnectTagsRequest) ProtoMessage() {}
 
-func (x *GetSimilarPlacesResponse) ProtoReflect() protoreflect.Message {
+func (x *DisconnectTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5239,28 +5667,33 @@ func (x *GetSimilarPlacesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimilarPlacesResponse.ProtoReflect.Descriptor instead.
-func (*GetSimilarPlacesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisconnectTagsRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectTagsRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetSimilarPlacesResponse) GetIds() []string {
+func (x *DisconnectTagsRequest) GetId1() string {
 	if x != nil {
-		return x.Ids
+		return x.Id1
 	}
-	return nil
+	return ""
 }
 
-type GetLandmarkTagsWithScoreRequest struct {
+func (x *DisconnectTagsRequest) GetId2() string {
+	if x != nil {
+		return x.Id2
+	}
+	return ""
+}
+
+type DisconnectTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetLandmarkTagsWithScoreRequest) Reset() {
-	*x = GetLandmarkTagsWithScoreRequest{}
+func (x *DisconnectTagsResponse) Reset() {
+	*x = DisconnectTagsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5268,13 +5701,13 @@ func (x *GetLandmarkTagsWithScoreRequest) Reset() {
 	}
 }
 
-func (x *GetLandmarkTagsWithScoreRequest) String() string {
+func (x *DisconnectTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarkTagsWithScoreRequest) ProtoMessage() {}
+func (*DisconnectTagsResponse) ProtoMessage() {}
 
-func (x *GetLandmarkTagsWithScoreRequest) ProtoReflect() protoreflect.Message {
+func (x *DisconnectTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5286,29 +5719,21 @@ func (x *GetLandmarkTagsWithScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarkTagsWithScoreRequest.ProtoReflect.Descriptor instead.
-func (*GetLandmarkTagsWithScoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisconnectTagsResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectTagsResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetLandmarkTagsWithScoreRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type TagIdScore struct {
+type DeleteTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TagId string  `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
-	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TagIdScore) Reset() {
-	*x = TagIdScore{}
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5316,13 +5741,13 @@ func (x *TagIdScore) Reset() {
 	}
 }
 
-func (x *TagIdScore) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagIdScore) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *TagIdScore) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5334,35 +5759,26 @@ func (x *TagIdScore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagIdScore.ProtoReflect.Descriptor instead.
-func (*TagIdScore) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *TagIdScore) GetTagId() string {
+func (x *DeleteTagRequest) GetId() string {
 	if x != nil {
-		return x.TagId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TagIdScore) GetScore() float32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-type GetLandmarkTagsWithScoreResponse struct {
+type DeleteTagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Tags []*TagIdScore `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *GetLandmarkTagsWithScoreResponse) Reset() {
-	*x = GetLandmarkTagsWithScoreResponse{}
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5370,13 +5786,13 @@ func (x *GetLandmarkTagsWithScoreResponse) Reset() {
 	}
 }
 
-func (x *GetLandmarkTagsWithScoreResponse) String() string {
+func (x *DeleteTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLandmarkTagsWithScoreResponse) ProtoMessage() {}
+func (*DeleteTagResponse) ProtoMessage() {}
 
-func (x *GetLandmarkTagsWithScoreResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5388,34 +5804,23 @@ func (x *GetLandmarkTagsWithScoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLandmarkTagsWithScoreResponse.ProtoReflect.Descriptor instead.
-func (*GetLandmarkTagsWithScoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetLandmarkTagsWithScoreResponse) GetTags() []*TagIdScore {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-type GetActivityRequest struct {
+type AddLandmarkTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Activity  string       `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
-	Northeast *Coordinates `protobuf:"bytes,2,opt,name=northeast,proto3" json:"northeast,omitempty"`
-	Southeast *Coordinates `protobuf:"bytes,3,opt,name=southeast,proto3" json:"southeast,omitempty"`
-	Limit     int32        `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	Include   []string     `protobuf:"bytes,6,rep,name=include,proto3" json:"include,omitempty"`
-	Exclude   []string     `protobuf:"bytes,7,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	LandmarkId string  `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	TagId      string  `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
+	Score      float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *GetActivityRequest) Reset() {
-	*x = GetActivityRequest{}
+func (x *AddLandmarkTagRequest) Reset() {
+	*x = AddLandmarkTagRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_storage_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5423,13 +5828,13 @@ func (x *GetActivityRequest) Reset() {
 	}
 }
 
-func (x *GetActivityRequest) String() string {
+func (x *AddLandmarkTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityRequest) ProtoMessage() {}
+func (*AddLandmarkTagRequest) ProtoMessage() {}
 
-func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
+func (x *AddLandmarkTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_storage_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5441,89 +5846,96 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
-func (*GetActivityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddLandmarkTagRequest.ProtoReflect.Descriptor instead.
+func (*AddLandmarkTagRequest) Descriptor() ([]byte, []int) {
 	return file_storage_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetActivityRequest) GetActivity() string {
+func (x *AddLandmarkTagRequest) GetLandmarkId() string {
 	if x != nil {
-		return x.Activity
+		return x.LandmarkId
 	}
 	return ""
 }
 
-func (x *GetActivityRequest) GetNortheast() *Coordinates {
+func (x *AddLandmarkTagRequest) GetTagId() string {
 	if x != nil {
-		return x.Northeast
+		return x.TagId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetActivityRequest) GetSoutheast() *Coordinates {
+func (x *AddLandmarkTagRequest) GetScore() float32 {
 	if x != nil {
-		return x.Southeast
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-func (x *GetActivityRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+type AddLandmarkTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetActivityRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
+func (x *AddLandmarkTagResponse) Reset() {
+	*x = AddLandmarkTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetActivityRequest) GetInclude() []string {
-	if x != nil {
-		return x.Include
-	}
-	return nil
+func (x *AddLandmarkTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetActivityRequest) GetExclude() []string {
-	if x != nil {
-		return x.Exclude
+func (*AddLandmarkTagResponse) ProtoMessage() {}
+
+func (x *AddLandmarkTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type LandmarkItem struct {
+// Deprecated: Use AddLandmarkTagResponse.ProtoReflect.Descriptor instead.
+func (*AddLandmarkTagResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{107}
+}
+
+type RemoveLandmarkTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Score     float32  `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
-	Latitude  float32  `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float32  `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Tags      []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	TagId      string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
 }
 
-func (x *LandmarkItem) Reset() {
-	*x = LandmarkItem{}
+func (x *RemoveLandmarkTagRequest) Reset() {
+	*x = RemoveLandmarkTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_proto_msgTypes[107]
+		mi := &file_storage_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LandmarkItem) String() string {
+func (x *RemoveLandmarkTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LandmarkItem) ProtoMessage() {}
+func (*RemoveLandmarkTagRequest) ProtoMessage() {}
 
-func (x *LandmarkItem) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_proto_msgTypes[107]
+func (x *RemoveLandmarkTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5534,71 +5946,88 @@ func (x *LandmarkItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LandmarkItem.ProtoReflect.Descriptor instead.
-func (*LandmarkItem) Descriptor() ([]byte, []int) {
-	return file_storage_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use RemoveLandmarkTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLandmarkTagRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *LandmarkItem) GetId() string {
+func (x *RemoveLandmarkTagRequest) GetLandmarkId() string {
 	if x != nil {
-		return x.Id
+		return x.LandmarkId
 	}
 	return ""
 }
 
-func (x *LandmarkItem) GetScore() float32 {
+func (x *RemoveLandmarkTagRequest) GetTagId() string {
 	if x != nil {
-		return x.Score
+		return x.TagId
 	}
-	return 0
+	return ""
 }
 
-func (x *LandmarkItem) GetLatitude() float32 {
-	if x != nil {
-		return x.Latitude
-	}
-	return 0
+type RemoveLandmarkTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LandmarkItem) GetLongitude() float32 {
-	if x != nil {
-		return x.Longitude
+func (x *RemoveLandmarkTagResponse) Reset() {
+	*x = RemoveLandmarkTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LandmarkItem) GetTags() []string {
-	if x != nil {
-		return x.Tags
+func (x *RemoveLandmarkTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLandmarkTagResponse) ProtoMessage() {}
+
+func (x *RemoveLandmarkTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type GetActivityResponse struct {
+// Deprecated: Use RemoveLandmarkTagResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLandmarkTagResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{109}
+}
+
+type GetLandmarkTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*LandmarkItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
 }
 
-func (x *GetActivityResponse) Reset() {
-	*x = GetActivityResponse{}
+func (x *GetLandmarkTagsRequest) Reset() {
+	*x = GetLandmarkTagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storage_proto_msgTypes[108]
+		mi := &file_storage_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetActivityResponse) String() string {
+func (x *GetLandmarkTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityResponse) ProtoMessage() {}
+func (*GetLandmarkTagsRequest) ProtoMessage() {}
 
-func (x *GetActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storage_proto_msgTypes[108]
+func (x *GetLandmarkTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5609,1056 +6038,4599 @@ func (x *GetActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityResponse.ProtoReflect.Descriptor instead.
-func (*GetActivityResponse) Descriptor() ([]byte, []int) {
-	return file_storage_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use GetLandmarkTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetLandmarkTagsRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetActivityResponse) GetItems() []*LandmarkItem {
+func (x *GetLandmarkTagsRequest) GetLandmarkId() string {
 	if x != nil {
-		return x.Items
+		return x.LandmarkId
 	}
-	return nil
+	return ""
 }
 
-var File_storage_proto protoreflect.FileDescriptor
+type GetLandmarkTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_storage_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x10, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x4c, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x74, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x4c, 0x69,
-	0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x6b,
-	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49,
-	0x64, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x56,
-	0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x69,
-	0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x2e, 0x0a, 0x1a, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x11, 0x0a,
-	0x0f, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x9c, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22,
-	0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x5e, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
-	0x15, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7a, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xde, 0x01, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6e,
-	0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e,
-	0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74,
-	0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x74,
-	0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x31, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73,
-	0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x27, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6c, 0x6c,
-	0x75, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x6c, 0x6c, 0x75, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x65,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x41,
-	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x26, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x12, 0x14,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x32, 0x22, 0x2e, 0x0a, 0x10, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x12, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69,
-	0x64, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x64, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3b, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54,
-	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x10, 0x0a, 0x03,
-	0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x32, 0x22, 0x18,
-	0x0a, 0x16, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x63, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61,
-	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67,
-	0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x38, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x22, 0x45, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x53,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x14,
-	0x0a, 0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73,
-	0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x22, 0x2d,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42, 0x79,
-	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xf9, 0x01,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x3b,
-	0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
-	0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69,
-	0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6f, 0x0a, 0x13, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x63, 0x0a, 0x22, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46, 0x61,
-	0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x4b, 0x0a, 0x11, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x12,
-	0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x65, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x4f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x43, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x71, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x35, 0x0a,
-	0x06, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f,
-	0x6f, 0x72, 0x64, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x7e, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x22, 0x29, 0x0a, 0x13, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x12,
-	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x0a, 0x54, 0x61, 0x67,
-	0x49, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x22, 0x54, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x64, 0x53, 0x63,
-	0x6f, 0x72, 0x65, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09,
-	0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09,
-	0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75,
-	0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f, 0x75,
-	0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x4b, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xfe, 0x26, 0x0a, 0x0e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x24, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67,
-	0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42, 0x79, 0x54, 0x61,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x41,
-	0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64,
-	0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x4c, 0x69, 0x6b,
-	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6b,
-	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x44, 0x69,
-	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x28, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73,
-	0x12, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x56, 0x69, 0x65,
-	0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x69, 0x65,
-	0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
-	0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
-	0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b,
-	0x65, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a,
-	0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46,
-	0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x27, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6e, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
-	0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71,
-	0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65,
-	0x65, 0x64, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46,
-	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69,
-	0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c,
-	0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x50, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b,
-	0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c,
-	0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x56, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x22,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x41,
-	0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x12, 0x27, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12,
-	0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x53, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x65, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54,
-	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x54, 0x61, 0x67, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
-	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61,
-	0x67, 0x73, 0x12, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x2e,
-	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
-	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c,
-	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e,
-	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x31, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
-	0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
-	0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x14, 0x5a, 0x12, 0x2e,
-	0x2f, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-var (
-	file_storage_proto_rawDescOnce sync.Once
-	file_storage_proto_rawDescData = file_storage_proto_rawDesc
-)
+func (x *GetLandmarkTagsResponse) Reset() {
+	*x = GetLandmarkTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_storage_proto_rawDescGZIP() []byte {
-	file_storage_proto_rawDescOnce.Do(func() {
-		file_storage_proto_rawDescData = protoimpl.X.CompressGZIP(file_storage_proto_rawDescData)
-	})
-	return file_storage_proto_rawDescData
+func (x *GetLandmarkTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 109)
-var file_storage_proto_goTypes = []interface{}{
-	(*Coordinates)(nil),                        // 0: landmark.storage.Coordinates
-	(*GetLandmarkRequest)(nil),                 // 1: landmark.storage.GetLandmarkRequest
-	(*GetLandmarkResponse)(nil),                // 2: landmark.storage.GetLandmarkResponse
-	(*AddLandmarkRequest)(nil),                 // 3: landmark.storage.AddLandmarkRequest
-	(*AddLandmarkResponse)(nil),                // 4: landmark.storage.AddLandmarkResponse
-	(*LikeLandmarkRequest)(nil),                // 5: landmark.storage.LikeLandmarkRequest
-	(*LikeLandmarkResponse)(nil),               // 6: landmark.storage.LikeLandmarkResponse
-	(*DislikeLandmarkRequest)(nil),             // 7: landmark.storage.DislikeLandmarkRequest
-	(*DislikeLandmarkResponse)(nil),            // 8: landmark.storage.DislikeLandmarkResponse
-	(*GetLikesRequest)(nil),                    // 9: landmark.storage.GetLikesRequest
-	(*GetLikesResponse)(nil),                   // 10: landmark.storage.GetLikesResponse
-	(*ViewLandmarkRequest)(nil),                // 11: landmark.storage.ViewLandmarkRequest
-	(*ViewLandmarkResponse)(nil),               // 12: landmark.storage.ViewLandmarkResponse
-	(*RecommendLandmarksRequest)(nil),          // 13: landmark.storage.RecommendLandmarksRequest
-	(*RecommendLandmarksResponse)(nil),         // 14: landmark.storage.RecommendLandmarksResponse
-	(*GetRandomFeedRequest)(nil),               // 15: landmark.storage.GetRandomFeedRequest
-	(*GetRandomFeedResponse)(nil),              // 16: landmark.storage.GetRandomFeedResponse
-	(*AddUserRequest)(nil),                     // 17: landmark.storage.AddUserRequest
-	(*AddUserResponse)(nil),                    // 18: landmark.storage.AddUserResponse
-	(*CreateCommentRequest)(nil),               // 19: landmark.storage.CreateCommentRequest
-	(*CreateCommentResponse)(nil),              // 20: landmark.storage.CreateCommentResponse
-	(*DeleteCommentRequest)(nil),               // 21: landmark.storage.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),              // 22: landmark.storage.DeleteCommentResponse
-	(*EditCommentRequest)(nil),                 // 23: landmark.storage.EditCommentRequest
-	(*EditCommentResponse)(nil),                // 24: landmark.storage.EditCommentResponse
-	(*Comment)(nil),                            // 25: landmark.storage.Comment
-	(*GetCommentsRequest)(nil),                 // 26: landmark.storage.GetCommentsRequest
-	(*GetCommentsResponse)(nil),                // 27: landmark.storage.GetCommentsResponse
-	(*GetProfileCommentsRequest)(nil),          // 28: landmark.storage.GetProfileCommentsRequest
-	(*GetProfileCommentsResponse)(nil),         // 29: landmark.storage.GetProfileCommentsResponse
-	(*GetFavouriteLandmarksRequest)(nil),       // 30: landmark.storage.GetFavouriteLandmarksRequest
-	(*GetFavouriteLandmarksResponse)(nil),      // 31: landmark.storage.GetFavouriteLandmarksResponse
-	(*GetLikesAmountRequest)(nil),              // 32: landmark.storage.GetLikesAmountRequest
-	(*GetLikesAmountResponse)(nil),             // 33: landmark.storage.GetLikesAmountResponse
-	(*GetUserTagsRequest)(nil),                 // 34: landmark.storage.GetUserTagsRequest
-	(*GetUserTagsResponse)(nil),                // 35: landmark.storage.GetUserTagsResponse
-	(*GetFeaturesTopicsRequest)(nil),           // 36: landmark.storage.GetFeaturesTopicsRequest
-	(*FeaturedTopic)(nil),                      // 37: landmark.storage.FeaturedTopic
-	(*GetFeaturedTopicsResponse)(nil),          // 38: landmark.storage.GetFeaturedTopicsResponse
-	(*AddFriendRequest)(nil),                   // 39: landmark.storage.AddFriendRequest
-	(*AddFriendResponse)(nil),                  // 40: landmark.storage.AddFriendResponse
-	(*DeleteFriendRequest)(nil),                // 41: landmark.storage.DeleteFriendRequest
-	(*DeleteFriendResponse)(nil),               // 42: landmark.storage.DeleteFriendResponse
-	(*GetFriendsRequest)(nil),                  // 43: landmark.storage.GetFriendsRequest
-	(*GetFriendsResponse)(nil),                 // 44: landmark.storage.GetFriendsResponse
-	(*CountFriendsRequest)(nil),                // 45: landmark.storage.CountFriendsRequest
-	(*CountFriendsResponse)(nil),               // 46: landmark.storage.CountFriendsResponse
-	(*IsFriendRequest)(nil),                    // 47: landmark.storage.IsFriendRequest
-	(*IsFriendResponse)(nil),                   // 48: landmark.storage.IsFriendResponse
-	(*CountReviewsRequest)(nil),                // 49: landmark.storage.CountReviewsRequest
-	(*CountReviewsResponse)(nil),               // 50: landmark.storage.CountReviewsResponse
-	(*CreateTagRequest)(nil),                   // 51: landmark.storage.CreateTagRequest
-	(*CreateTagResponse)(nil),                  // 52: landmark.storage.CreateTagResponse
-	(*ConnectTagsRequest)(nil),                 // 53: landmark.storage.ConnectTagsRequest
-	(*ConnectTagsResponse)(nil),                // 54: landmark.storage.ConnectTagsResponse
-	(*DisconnectTagsRequest)(nil),              // 55: landmark.storage.DisconnectTagsRequest
-	(*DisconnectTagsResponse)(nil),             // 56: landmark.storage.DisconnectTagsResponse
-	(*DeleteTagRequest)(nil),                   // 57: landmark.storage.DeleteTagRequest
-	(*DeleteTagResponse)(nil),                  // 58: landmark.storage.DeleteTagResponse
-	(*AddLandmarkTagRequest)(nil),              // 59: landmark.storage.AddLandmarkTagRequest
-	(*AddLandmarkTagResponse)(nil),             // 60: landmark.storage.AddLandmarkTagResponse
-	(*RemoveLandmarkTagRequest)(nil),           // 61: landmark.storage.RemoveLandmarkTagRequest
-	(*RemoveLandmarkTagResponse)(nil),          // 62: landmark.storage.RemoveLandmarkTagResponse
-	(*GetLandmarkTagsRequest)(nil),             // 63: landmark.storage.GetLandmarkTagsRequest
-	(*GetLandmarkTagsResponse)(nil),            // 64: landmark.storage.GetLandmarkTagsResponse
-	(*GetConnectedTagsRequest)(nil),            // 65: landmark.storage.GetConnectedTagsRequest
-	(*Tag)(nil),                                // 66: landmark.storage.Tag
-	(*GetConnectedTagsResponse)(nil),           // 67: landmark.storage.GetConnectedTagsResponse
-	(*SetUserTagRequest)(nil),                  // 68: landmark.storage.SetUserTagRequest
-	(*SetUserTagResponse)(nil),                 // 69: landmark.storage.SetUserTagResponse
-	(*DeleteUserTagRequest)(nil),               // 70: landmark.storage.DeleteUserTagRequest
-	(*DeleteUserTagResponse)(nil),              // 71: landmark.storage.DeleteUserTagResponse
-	(*GetLandmarksByTagRequest)(nil),           // 72: landmark.storage.GetLandmarksByTagRequest
-	(*GetLandmarksByTagResponse)(nil),          // 73: landmark.storage.GetLandmarksByTagResponse
-	(*GetLandmarksFilteredRequest)(nil),        // 74: landmark.storage.GetLandmarksFilteredRequest
-	(*GetLandmarksFilteredResponse)(nil),       // 75: landmark.storage.GetLandmarksFilteredResponse
-	(*UpdateLandmarkScoreRequest)(nil),         // 76: landmark.storage.UpdateLandmarkScoreRequest
-	(*UpdateLandmarkScoreResponse)(nil),        // 77: landmark.storage.UpdateLandmarkScoreResponse
-	(*GetRecentFriendsFavouritesRequest)(nil),  // 78: landmark.storage.GetRecentFriendsFavouritesRequest
-	(*FriendLikedLandmark)(nil),                // 79: landmark.storage.FriendLikedLandmark
-	(*GetRecentFriendsFavouritesResponse)(nil), // 80: landmark.storage.GetRecentFriendsFavouritesResponse
-	(*IsReviewedRequest)(nil),                  // 81: landmark.storage.IsReviewedRequest
-	(*IsReviewedResponse)(nil),                 // 82: landmark.storage.IsReviewedResponse
-	(*GetReviewRequest)(nil),                   // 83: landmark.storage.GetReviewRequest
-	(*GetReviewResponse)(nil),                  // 84: landmark.storage.GetReviewResponse
-	(*SetLandmarkScoreRequest)(nil),            // 85: landmark.storage.SetLandmarkScoreRequest
-	(*SetLandmarkScoreResponse)(nil),           // 86: landmark.storage.SetLandmarkScoreResponse
-	(*SetMultipleViewedRequest)(nil),           // 87: landmark.storage.SetMultipleViewedRequest
-	(*SetMultipleViewedResponse)(nil),          // 88: landmark.storage.SetMultipleViewedResponse
-	(*NotInterestedRequest)(nil),               // 89: landmark.storage.NotInterestedRequest
-	(*NotInterestedResponse)(nil),              // 90: landmark.storage.NotInterestedResponse
-	(*ChangeUserTagsRequest)(nil),              // 91: landmark.storage.ChangeUserTagsRequest
-	(*ChangeUserTagsResponse)(nil),             // 92: landmark.storage.ChangeUserTagsResponse
-	(*DeleteLandmarkRequest)(nil),              // 93: landmark.storage.DeleteLandmarkRequest
-	(*DeleteLandmarkResponse)(nil),             // 94: landmark.storage.DeleteLandmarkResponse
-	(*SetLandmarkCoordsRequest)(nil),           // 95: landmark.storage.SetLandmarkCoordsRequest
-	(*SetLandmarkCoordsResponse)(nil),          // 96: landmark.storage.SetLandmarkCoordsResponse
-	(*TestGetFeedRequest)(nil),                 // 97: landmark.storage.TestGetFeedRequest
-	(*TestGetFeedResponse)(nil),                // 98: landmark.storage.TestGetFeedResponse
-	(*SetNodeNameRequest)(nil),                 // 99: landmark.storage.SetNodeNameRequest
-	(*SetNodeNameResponse)(nil),                // 100: landmark.storage.SetNodeNameResponse
-	(*GetSimilarPlacesRequest)(nil),            // 101: landmark.storage.GetSimilarPlacesRequest
-	(*GetSimilarPlacesResponse)(nil),           // 102: landmark.storage.GetSimilarPlacesResponse
-	(*GetLandmarkTagsWithScoreRequest)(nil),    // 103: landmark.storage.GetLandmarkTagsWithScoreRequest
-	(*TagIdScore)(nil),                         // 104: landmark.storage.TagIdScore
-	(*GetLandmarkTagsWithScoreResponse)(nil),   // 105: landmark.storage.GetLandmarkTagsWithScoreResponse
-	(*GetActivityRequest)(nil),                 // 106: landmark.storage.GetActivityRequest
-	(*LandmarkItem)(nil),                       // 107: landmark.storage.LandmarkItem
-	(*GetActivityResponse)(nil),                // 108: landmark.storage.GetActivityResponse
+func (*GetLandmarkTagsResponse) ProtoMessage() {}
+
+func (x *GetLandmarkTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_storage_proto_depIdxs = []int32{
-	25,  // 0: landmark.storage.GetCommentsResponse.comments:type_name -> landmark.storage.Comment
-	25,  // 1: landmark.storage.GetProfileCommentsResponse.comments:type_name -> landmark.storage.Comment
-	0,   // 2: landmark.storage.GetFavouriteLandmarksRequest.northeast:type_name -> landmark.storage.Coordinates
-	0,   // 3: landmark.storage.GetFavouriteLandmarksRequest.southeast:type_name -> landmark.storage.Coordinates
-	37,  // 4: landmark.storage.GetFeaturedTopicsResponse.topics:type_name -> landmark.storage.FeaturedTopic
-	66,  // 5: landmark.storage.GetConnectedTagsResponse.tags:type_name -> landmark.storage.Tag
-	0,   // 6: landmark.storage.GetLandmarksByTagRequest.northeast:type_name -> landmark.storage.Coordinates
-	0,   // 7: landmark.storage.GetLandmarksByTagRequest.southeast:type_name -> landmark.storage.Coordinates
-	0,   // 8: landmark.storage.GetLandmarksFilteredRequest.northeast:type_name -> landmark.storage.Coordinates
-	0,   // 9: landmark.storage.GetLandmarksFilteredRequest.southeast:type_name -> landmark.storage.Coordinates
-	79,  // 10: landmark.storage.GetRecentFriendsFavouritesResponse.result:type_name -> landmark.storage.FriendLikedLandmark
-	25,  // 11: landmark.storage.GetReviewResponse.review:type_name -> landmark.storage.Comment
-	0,   // 12: landmark.storage.SetLandmarkCoordsRequest.coords:type_name -> landmark.storage.Coordinates
-	104, // 13: landmark.storage.GetLandmarkTagsWithScoreResponse.tags:type_name -> landmark.storage.TagIdScore
-	0,   // 14: landmark.storage.GetActivityRequest.northeast:type_name -> landmark.storage.Coordinates
-	0,   // 15: landmark.storage.GetActivityRequest.southeast:type_name -> landmark.storage.Coordinates
-	107, // 16: landmark.storage.GetActivityResponse.items:type_name -> landmark.storage.LandmarkItem
-	1,   // 17: landmark.storage.StorageService.GetLandmark:input_type -> landmark.storage.GetLandmarkRequest
-	72,  // 18: landmark.storage.StorageService.GetLandmarksByTag:input_type -> landmark.storage.GetLandmarksByTagRequest
-	3,   // 19: landmark.storage.StorageService.AddLandmark:input_type -> landmark.storage.AddLandmarkRequest
-	5,   // 20: landmark.storage.StorageService.LikeLandmark:input_type -> landmark.storage.LikeLandmarkRequest
-	7,   // 21: landmark.storage.StorageService.DislikeLandmark:input_type -> landmark.storage.DislikeLandmarkRequest
-	9,   // 22: landmark.storage.StorageService.GetLikes:input_type -> landmark.storage.GetLikesRequest
-	11,  // 23: landmark.storage.StorageService.ViewLandmark:input_type -> landmark.storage.ViewLandmarkRequest
-	30,  // 24: landmark.storage.StorageService.GetFavouriteLandmarks:input_type -> landmark.storage.GetFavouriteLandmarksRequest
-	32,  // 25: landmark.storage.StorageService.GetLikesAmount:input_type -> landmark.storage.GetLikesAmountRequest
-	74,  // 26: landmark.storage.StorageService.GetLandmarksFiltered:input_type -> landmark.storage.GetLandmarksFilteredRequest
-	76,  // 27: landmark.storage.StorageService.UpdateLandmarkScore:input_type -> landmark.storage.UpdateLandmarkScoreRequest
-	78,  // 28: landmark.storage.StorageService.GetRecentFriendsFavourites:input_type -> landmark.storage.GetRecentFriendsFavouritesRequest
-	85,  // 29: landmark.storage.StorageService.SetLandmarkScore:input_type -> landmark.storage.SetLandmarkScoreRequest
-	89,  // 30: landmark.storage.StorageService.NotInterested:input_type -> landmark.storage.NotInterestedRequest
-	93,  // 31: landmark.storage.StorageService.DeleteLandmark:input_type -> landmark.storage.DeleteLandmarkRequest
-	95,  // 32: landmark.storage.StorageService.SetLandmarkCoords:input_type -> landmark.storage.SetLandmarkCoordsRequest
-	106, // 33: landmark.storage.StorageService.GetActivity:input_type -> landmark.storage.GetActivityRequest
-	13,  // 34: landmark.storage.StorageService.RecommendLandmarks:input_type -> landmark.storage.RecommendLandmarksRequest
-	15,  // 35: landmark.storage.StorageService.GetRandomFeed:input_type -> landmark.storage.GetRandomFeedRequest
-	101, // 36: landmark.storage.StorageService.GetSimilarPlaces:input_type -> landmark.storage.GetSimilarPlacesRequest
-	17,  // 37: landmark.storage.StorageService.AddUser:input_type -> landmark.storage.AddUserRequest
-	19,  // 38: landmark.storage.StorageService.CreateComment:input_type -> landmark.storage.CreateCommentRequest
-	21,  // 39: landmark.storage.StorageService.DeleteComment:input_type -> landmark.storage.DeleteCommentRequest
-	23,  // 40: landmark.storage.StorageService.EditComment:input_type -> landmark.storage.EditCommentRequest
-	26,  // 41: landmark.storage.StorageService.GetComments:input_type -> landmark.storage.GetCommentsRequest
-	28,  // 42: landmark.storage.StorageService.GetProfileComments:input_type -> landmark.storage.GetProfileCommentsRequest
-	49,  // 43: landmark.storage.StorageService.CountReviews:input_type -> landmark.storage.CountReviewsRequest
-	81,  // 44: landmark.storage.StorageService.IsReviewedBy:input_type -> landmark.storage.IsReviewedRequest
-	83,  // 45: landmark.storage.StorageService.GetReview:input_type -> landmark.storage.GetReviewRequest
-	39,  // 46: landmark.storage.StorageService.AddFriend:input_type -> landmark.storage.AddFriendRequest
-	41,  // 47: landmark.storage.StorageService.DeleteFriend:input_type -> landmark.storage.DeleteFriendRequest
-	43,  // 48: landmark.storage.StorageService.GetFriends:input_type -> landmark.storage.GetFriendsRequest
-	45,  // 49: landmark.storage.StorageService.CountFriends:input_type -> landmark.storage.CountFriendsRequest
-	47,  // 50: landmark.storage.StorageService.IsFriend:input_type -> landmark.storage.IsFriendRequest
-	59,  // 51: landmark.storage.StorageService.AddLandmarkTag:input_type -> landmark.storage.AddLandmarkTagRequest
-	61,  // 52: landmark.storage.StorageService.RemoveLandmarkTag:input_type -> landmark.storage.RemoveLandmarkTagRequest
-	51,  // 53: landmark.storage.StorageService.CreateTag:input_type -> landmark.storage.CreateTagRequest
-	68,  // 54: landmark.storage.StorageService.SetUserTag:input_type -> landmark.storage.SetUserTagRequest
-	70,  // 55: landmark.storage.StorageService.DeleteUserTag:input_type -> landmark.storage.DeleteUserTagRequest
-	34,  // 56: landmark.storage.StorageService.GetUserTags:input_type -> landmark.storage.GetUserTagsRequest
-	63,  // 57: landmark.storage.StorageService.GetLandmarkTags:input_type -> landmark.storage.GetLandmarkTagsRequest
-	53,  // 58: landmark.storage.StorageService.ConnectTags:input_type -> landmark.storage.ConnectTagsRequest
-	55,  // 59: landmark.storage.StorageService.DisconnectTags:input_type -> landmark.storage.DisconnectTagsRequest
-	57,  // 60: landmark.storage.StorageService.DeleteTag:input_type -> landmark.storage.DeleteTagRequest
-	65,  // 61: landmark.storage.StorageService.GetConnectedTags:input_type -> landmark.storage.GetConnectedTagsRequest
-	91,  // 62: landmark.storage.StorageService.ChangeUserTags:input_type -> landmark.storage.ChangeUserTagsRequest
-	97,  // 63: landmark.storage.StorageService.TestGetRecommended:input_type -> landmark.storage.TestGetFeedRequest
-	99,  // 64: landmark.storage.StorageService.SetNodeName:input_type -> landmark.storage.SetNodeNameRequest
-	103, // 65: landmark.storage.StorageService.GetLandmarkTagsWithScore:input_type -> landmark.storage.GetLandmarkTagsWithScoreRequest
-	2,   // 66: landmark.storage.StorageService.GetLandmark:output_type -> landmark.storage.GetLandmarkResponse
-	73,  // 67: landmark.storage.StorageService.GetLandmarksByTag:output_type -> landmark.storage.GetLandmarksByTagResponse
-	4,   // 68: landmark.storage.StorageService.AddLandmark:output_type -> landmark.storage.AddLandmarkResponse
-	6,   // 69: landmark.storage.StorageService.LikeLandmark:output_type -> landmark.storage.LikeLandmarkResponse
-	8,   // 70: landmark.storage.StorageService.DislikeLandmark:output_type -> landmark.storage.DislikeLandmarkResponse
-	10,  // 71: landmark.storage.StorageService.GetLikes:output_type -> landmark.storage.GetLikesResponse
-	12,  // 72: landmark.storage.StorageService.ViewLandmark:output_type -> landmark.storage.ViewLandmarkResponse
-	31,  // 73: landmark.storage.StorageService.GetFavouriteLandmarks:output_type -> landmark.storage.GetFavouriteLandmarksResponse
-	33,  // 74: landmark.storage.StorageService.GetLikesAmount:output_type -> landmark.storage.GetLikesAmountResponse
-	75,  // 75: landmark.storage.StorageService.GetLandmarksFiltered:output_type -> landmark.storage.GetLandmarksFilteredResponse
-	77,  // 76: landmark.storage.StorageService.UpdateLandmarkScore:output_type -> landmark.storage.UpdateLandmarkScoreResponse
-	80,  // 77: landmark.storage.StorageService.GetRecentFriendsFavourites:output_type -> landmark.storage.GetRecentFriendsFavouritesResponse
-	86,  // 78: landmark.storage.StorageService.SetLandmarkScore:output_type -> landmark.storage.SetLandmarkScoreResponse
-	90,  // 79: landmark.storage.StorageService.NotInterested:output_type -> landmark.storage.NotInterestedResponse
-	94,  // 80: landmark.storage.StorageService.DeleteLandmark:output_type -> landmark.storage.DeleteLandmarkResponse
-	96,  // 81: landmark.storage.StorageService.SetLandmarkCoords:output_type -> landmark.storage.SetLandmarkCoordsResponse
-	108, // 82: landmark.storage.StorageService.GetActivity:output_type -> landmark.storage.GetActivityResponse
-	14,  // 83: landmark.storage.StorageService.RecommendLandmarks:output_type -> landmark.storage.RecommendLandmarksResponse
-	16,  // 84: landmark.storage.StorageService.GetRandomFeed:output_type -> landmark.storage.GetRandomFeedResponse
-	102, // 85: landmark.storage.StorageService.GetSimilarPlaces:output_type -> landmark.storage.GetSimilarPlacesResponse
-	18,  // 86: landmark.storage.StorageService.AddUser:output_type -> landmark.storage.AddUserResponse
-	20,  // 87: landmark.storage.StorageService.CreateComment:output_type -> landmark.storage.CreateCommentResponse
-	22,  // 88: landmark.storage.StorageService.DeleteComment:output_type -> landmark.storage.DeleteCommentResponse
-	24,  // 89: landmark.storage.StorageService.EditComment:output_type -> landmark.storage.EditCommentResponse
-	27,  // 90: landmark.storage.StorageService.GetComments:output_type -> landmark.storage.GetCommentsResponse
-	29,  // 91: landmark.storage.StorageService.GetProfileComments:output_type -> landmark.storage.GetProfileCommentsResponse
-	50,  // 92: landmark.storage.StorageService.CountReviews:output_type -> landmark.storage.CountReviewsResponse
-	82,  // 93: landmark.storage.StorageService.IsReviewedBy:output_type -> landmark.storage.IsReviewedResponse
-	84,  // 94: landmark.storage.StorageService.GetReview:output_type -> landmark.storage.GetReviewResponse
-	40,  // 95: landmark.storage.StorageService.AddFriend:output_type -> landmark.storage.AddFriendResponse
-	42,  // 96: landmark.storage.StorageService.DeleteFriend:output_type -> landmark.storage.DeleteFriendResponse
-	44,  // 97: landmark.storage.StorageService.GetFriends:output_type -> landmark.storage.GetFriendsResponse
-	46,  // 98: landmark.storage.StorageService.CountFriends:output_type -> landmark.storage.CountFriendsResponse
-	48,  // 99: landmark.storage.StorageService.IsFriend:output_type -> landmark.storage.IsFriendResponse
-	60,  // 100: landmark.storage.StorageService.AddLandmarkTag:output_type -> landmark.storage.AddLandmarkTagResponse
-	62,  // 101: landmark.storage.StorageService.RemoveLandmarkTag:output_type -> landmark.storage.RemoveLandmarkTagResponse
-	52,  // 102: landmark.storage.StorageService.CreateTag:output_type -> landmark.storage.CreateTagResponse
-	69,  // 103: landmark.storage.StorageService.SetUserTag:output_type -> landmark.storage.SetUserTagResponse
-	71,  // 104: landmark.storage.StorageService.DeleteUserTag:output_type -> landmark.storage.DeleteUserTagResponse
-	35,  // 105: landmark.storage.StorageService.GetUserTags:output_type -> landmark.storage.GetUserTagsResponse
-	64,  // 106: landmark.storage.StorageService.GetLandmarkTags:output_type -> landmark.storage.GetLandmarkTagsResponse
-	54,  // 107: landmark.storage.StorageService.ConnectTags:output_type -> landmark.storage.ConnectTagsResponse
-	56,  // 108: landmark.storage.StorageService.DisconnectTags:output_type -> landmark.storage.DisconnectTagsResponse
-	58,  // 109: landmark.storage.StorageService.DeleteTag:output_type -> landmark.storage.DeleteTagResponse
-	67,  // 110: landmark.storage.StorageService.GetConnectedTags:output_type -> landmark.storage.GetConnectedTagsResponse
-	92,  // 111: landmark.storage.StorageService.ChangeUserTags:output_type -> landmark.storage.ChangeUserTagsResponse
-	98,  // 112: landmark.storage.StorageService.TestGetRecommended:output_type -> landmark.storage.TestGetFeedResponse
-	100, // 113: landmark.storage.StorageService.SetNodeName:output_type -> landmark.storage.SetNodeNameResponse
-	105, // 114: landmark.storage.StorageService.GetLandmarkTagsWithScore:output_type -> landmark.storage.GetLandmarkTagsWithScoreResponse
-	66,  // [66:115] is the sub-list for method output_type
-	17,  // [17:66] is the sub-list for method input_type
-	17,  // [17:17] is the sub-list for extension type_name
-	17,  // [17:17] is the sub-list for extension extendee
-	0,   // [0:17] is the sub-list for field type_name
+
+// Deprecated: Use GetLandmarkTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetLandmarkTagsResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{111}
 }
 
-func init() { file_storage_proto_init() }
-func file_storage_proto_init() {
-	if File_storage_proto != nil {
-		return
+func (x *GetLandmarkTagsResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coordinates); i {
+	return nil
+}
+
+type GetConnectedTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagId string `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
+}
+
+func (x *GetConnectedTagsRequest) Reset() {
+	*x = GetConnectedTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectedTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectedTagsRequest) ProtoMessage() {}
+
+func (x *GetConnectedTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectedTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectedTagsRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetConnectedTagsRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetConnectedTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetConnectedTagsResponse) Reset() {
+	*x = GetConnectedTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConnectedTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectedTagsResponse) ProtoMessage() {}
+
+func (x *GetConnectedTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectedTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectedTagsResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetConnectedTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetUserTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TagId  string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
+}
+
+func (x *SetUserTagRequest) Reset() {
+	*x = SetUserTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagRequest) ProtoMessage() {}
+
+func (x *SetUserTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTagRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SetUserTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type SetUserTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserTagResponse) Reset() {
+	*x = SetUserTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTagResponse) ProtoMessage() {}
+
+func (x *SetUserTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTagResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTagResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{116}
+}
+
+type DeleteUserTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TagId  string `protobuf:"bytes,2,opt,name=tagId,proto3" json:"tagId,omitempty"`
+}
+
+func (x *DeleteUserTagRequest) Reset() {
+	*x = DeleteUserTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserTagRequest) ProtoMessage() {}
+
+func (x *DeleteUserTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserTagRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DeleteUserTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type DeleteUserTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserTagResponse) Reset() {
+	*x = DeleteUserTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserTagResponse) ProtoMessage() {}
+
+func (x *DeleteUserTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserTagResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{118}
+}
+
+type GetLandmarksByTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagId     string       `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
+	Limit     int32        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset    int32        `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Northeast *Coordinates `protobuf:"bytes,4,opt,name=northeast,proto3" json:"northeast,omitempty"`
+	Southeast *Coordinates `protobuf:"bytes,5,opt,name=southeast,proto3" json:"southeast,omitempty"`
+}
+
+func (x *GetLandmarksByTagRequest) Reset() {
+	*x = GetLandmarksByTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarksByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarksByTagRequest) ProtoMessage() {}
+
+func (x *GetLandmarksByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarksByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetLandmarksByTagRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetLandmarksByTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *GetLandmarksByTagRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLandmarksByTagRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLandmarksByTagRequest) GetNortheast() *Coordinates {
+	if x != nil {
+		return x.Northeast
+	}
+	return nil
+}
+
+func (x *GetLandmarksByTagRequest) GetSoutheast() *Coordinates {
+	if x != nil {
+		return x.Southeast
+	}
+	return nil
+}
+
+type GetLandmarksByTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *GetLandmarksByTagResponse) Reset() {
+	*x = GetLandmarksByTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarksByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarksByTagResponse) ProtoMessage() {}
+
+func (x *GetLandmarksByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarksByTagResponse.ProtoReflect.Descriptor instead.
+func (*GetLandmarksByTagResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetLandmarksByTagResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetLandmarksFilteredRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Include   []string     `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude   []string     `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	Northeast *Coordinates `protobuf:"bytes,3,opt,name=northeast,proto3" json:"northeast,omitempty"`
+	Southeast *Coordinates `protobuf:"bytes,4,opt,name=southeast,proto3" json:"southeast,omitempty"`
+	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     int32        `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetLandmarksFilteredRequest) Reset() {
+	*x = GetLandmarksFilteredRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarksFilteredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarksFilteredRequest) ProtoMessage() {}
+
+func (x *GetLandmarksFilteredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarksFilteredRequest.ProtoReflect.Descriptor instead.
+func (*GetLandmarksFilteredRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetLandmarksFilteredRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetLandmarksFilteredRequest) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *GetLandmarksFilteredRequest) GetNortheast() *Coordinates {
+	if x != nil {
+		return x.Northeast
+	}
+	return nil
+}
+
+func (x *GetLandmarksFilteredRequest) GetSoutheast() *Coordinates {
+	if x != nil {
+		return x.Southeast
+	}
+	return nil
+}
+
+func (x *GetLandmarksFilteredRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLandmarksFilteredRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLandmarksFilteredResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *GetLandmarksFilteredResponse) Reset() {
+	*x = GetLandmarksFilteredResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarksFilteredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarksFilteredResponse) ProtoMessage() {}
+
+func (x *GetLandmarksFilteredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarksFilteredResponse.ProtoReflect.Descriptor instead.
+func (*GetLandmarksFilteredResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetLandmarksFilteredResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type UpdateLandmarkScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *UpdateLandmarkScoreRequest) Reset() {
+	*x = UpdateLandmarkScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLandmarkScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLandmarkScoreRequest) ProtoMessage() {}
+
+func (x *UpdateLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLandmarkScoreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLandmarkScoreRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UpdateLandmarkScoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLandmarkScoreRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type UpdateLandmarkScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateLandmarkScoreResponse) Reset() {
+	*x = UpdateLandmarkScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateLandmarkScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLandmarkScoreResponse) ProtoMessage() {}
+
+func (x *UpdateLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLandmarkScoreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLandmarkScoreResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{124}
+}
+
+type GetRecentFriendsFavouritesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetRecentFriendsFavouritesRequest) Reset() {
+	*x = GetRecentFriendsFavouritesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentFriendsFavouritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentFriendsFavouritesRequest) ProtoMessage() {}
+
+func (x *GetRecentFriendsFavouritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentFriendsFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentFriendsFavouritesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetRecentFriendsFavouritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecentFriendsFavouritesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRecentFriendsFavouritesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FriendLikedLandmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendId   string `protobuf:"bytes,1,opt,name=friendId,proto3" json:"friendId,omitempty"`
+	LandmarkId string `protobuf:"bytes,2,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	Timestamp  int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *FriendLikedLandmark) Reset() {
+	*x = FriendLikedLandmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendLikedLandmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendLikedLandmark) ProtoMessage() {}
+
+func (x *FriendLikedLandmark) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendLikedLandmark.ProtoReflect.Descriptor instead.
+func (*FriendLikedLandmark) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *FriendLikedLandmark) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+func (x *FriendLikedLandmark) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+func (x *FriendLikedLandmark) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetRecentFriendsFavouritesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*FriendLikedLandmark `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetRecentFriendsFavouritesResponse) Reset() {
+	*x = GetRecentFriendsFavouritesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentFriendsFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentFriendsFavouritesResponse) ProtoMessage() {}
+
+func (x *GetRecentFriendsFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentFriendsFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentFriendsFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetRecentFriendsFavouritesResponse) GetResult() []*FriendLikedLandmark {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type IsReviewedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *IsReviewedRequest) Reset() {
+	*x = IsReviewedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsReviewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsReviewedRequest) ProtoMessage() {}
+
+func (x *IsReviewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsReviewedRequest.ProtoReflect.Descriptor instead.
+func (*IsReviewedRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *IsReviewedRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+func (x *IsReviewedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type IsReviewedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsReviewed bool `protobuf:"varint,1,opt,name=isReviewed,proto3" json:"isReviewed,omitempty"`
+}
+
+func (x *IsReviewedResponse) Reset() {
+	*x = IsReviewedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsReviewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsReviewedResponse) ProtoMessage() {}
+
+func (x *IsReviewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsReviewedResponse.ProtoReflect.Descriptor instead.
+func (*IsReviewedResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *IsReviewedResponse) GetIsReviewed() bool {
+	if x != nil {
+		return x.IsReviewed
+	}
+	return false
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetReviewRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *Comment `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetReviewResponse) GetReview() *Comment {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type SetLandmarkScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LandmarkId string  `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	Score      float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *SetLandmarkScoreRequest) Reset() {
+	*x = SetLandmarkScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLandmarkScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLandmarkScoreRequest) ProtoMessage() {}
+
+func (x *SetLandmarkScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLandmarkScoreRequest.ProtoReflect.Descriptor instead.
+func (*SetLandmarkScoreRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SetLandmarkScoreRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+func (x *SetLandmarkScoreRequest) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SetLandmarkScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLandmarkScoreResponse) Reset() {
+	*x = SetLandmarkScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLandmarkScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLandmarkScoreResponse) ProtoMessage() {}
+
+func (x *SetLandmarkScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLandmarkScoreResponse.ProtoReflect.Descriptor instead.
+func (*SetLandmarkScoreResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{133}
+}
+
+type SetMultipleViewedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LandmarkIds []string `protobuf:"bytes,2,rep,name=landmarkIds,proto3" json:"landmarkIds,omitempty"`
+}
+
+func (x *SetMultipleViewedRequest) Reset() {
+	*x = SetMultipleViewedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMultipleViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMultipleViewedRequest) ProtoMessage() {}
+
+func (x *SetMultipleViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMultipleViewedRequest.ProtoReflect.Descriptor instead.
+func (*SetMultipleViewedRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SetMultipleViewedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMultipleViewedRequest) GetLandmarkIds() []string {
+	if x != nil {
+		return x.LandmarkIds
+	}
+	return nil
+}
+
+type SetMultipleViewedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMultipleViewedResponse) Reset() {
+	*x = SetMultipleViewedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMultipleViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMultipleViewedResponse) ProtoMessage() {}
+
+func (x *SetMultipleViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMultipleViewedResponse.ProtoReflect.Descriptor instead.
+func (*SetMultipleViewedResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{135}
+}
+
+type NotInterestedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LandmarkId string `protobuf:"bytes,2,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+}
+
+func (x *NotInterestedRequest) Reset() {
+	*x = NotInterestedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotInterestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotInterestedRequest) ProtoMessage() {}
+
+func (x *NotInterestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotInterestedRequest.ProtoReflect.Descriptor instead.
+func (*NotInterestedRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *NotInterestedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotInterestedRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+type NotInterestedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotInterestedResponse) Reset() {
+	*x = NotInterestedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotInterestedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotInterestedResponse) ProtoMessage() {}
+
+func (x *NotInterestedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotInterestedResponse.ProtoReflect.Descriptor instead.
+func (*NotInterestedResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{137}
+}
+
+type ChangeUserTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// If set, the change is rejected with ABORTED unless the current version matches
+	ExpectedVersion *int64 `protobuf:"varint,3,opt,name=expectedVersion,proto3,oneof" json:"expectedVersion,omitempty"`
+}
+
+func (x *ChangeUserTagsRequest) Reset() {
+	*x = ChangeUserTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUserTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserTagsRequest) ProtoMessage() {}
+
+func (x *ChangeUserTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserTagsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserTagsRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ChangeUserTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeUserTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ChangeUserTagsRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type ChangeUserTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ChangeUserTagsResponse) Reset() {
+	*x = ChangeUserTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUserTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserTagsResponse) ProtoMessage() {}
+
+func (x *ChangeUserTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserTagsResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserTagsResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ChangeUserTagsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteLandmarkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LandmarkId string `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+}
+
+func (x *DeleteLandmarkRequest) Reset() {
+	*x = DeleteLandmarkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLandmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLandmarkRequest) ProtoMessage() {}
+
+func (x *DeleteLandmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLandmarkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLandmarkRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *DeleteLandmarkRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+type DeleteLandmarkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLandmarkResponse) Reset() {
+	*x = DeleteLandmarkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLandmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLandmarkResponse) ProtoMessage() {}
+
+func (x *DeleteLandmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLandmarkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLandmarkResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{141}
+}
+
+type SetLandmarkCoordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LandmarkId string       `protobuf:"bytes,1,opt,name=landmarkId,proto3" json:"landmarkId,omitempty"`
+	Coords     *Coordinates `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+}
+
+func (x *SetLandmarkCoordsRequest) Reset() {
+	*x = SetLandmarkCoordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLandmarkCoordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLandmarkCoordsRequest) ProtoMessage() {}
+
+func (x *SetLandmarkCoordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLandmarkCoordsRequest.ProtoReflect.Descriptor instead.
+func (*SetLandmarkCoordsRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *SetLandmarkCoordsRequest) GetLandmarkId() string {
+	if x != nil {
+		return x.LandmarkId
+	}
+	return ""
+}
+
+func (x *SetLandmarkCoordsRequest) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+type SetLandmarkCoordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLandmarkCoordsResponse) Reset() {
+	*x = SetLandmarkCoordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLandmarkCoordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLandmarkCoordsResponse) ProtoMessage() {}
+
+func (x *SetLandmarkCoordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLandmarkCoordsResponse.ProtoReflect.Descriptor instead.
+func (*SetLandmarkCoordsResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{143}
+}
+
+type TestGetFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string   `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount      int32    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Latitude    float32  `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32  `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude64  *float64 `protobuf:"fixed64,5,opt,name=latitude64,proto3,oneof" json:"latitude64,omitempty"`
+	Longitude64 *float64 `protobuf:"fixed64,6,opt,name=longitude64,proto3,oneof" json:"longitude64,omitempty"`
+}
+
+func (x *TestGetFeedRequest) Reset() {
+	*x = TestGetFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestGetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestGetFeedRequest) ProtoMessage() {}
+
+func (x *TestGetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestGetFeedRequest.ProtoReflect.Descriptor instead.
+func (*TestGetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *TestGetFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TestGetFeedRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TestGetFeedRequest) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *TestGetFeedRequest) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *TestGetFeedRequest) GetLatitude64() float64 {
+	if x != nil && x.Latitude64 != nil {
+		return *x.Latitude64
+	}
+	return 0
+}
+
+func (x *TestGetFeedRequest) GetLongitude64() float64 {
+	if x != nil && x.Longitude64 != nil {
+		return *x.Longitude64
+	}
+	return 0
+}
+
+type TestGetFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Feed []string `protobuf:"bytes,1,rep,name=feed,proto3" json:"feed,omitempty"`
+}
+
+func (x *TestGetFeedResponse) Reset() {
+	*x = TestGetFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestGetFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestGetFeedResponse) ProtoMessage() {}
+
+func (x *TestGetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestGetFeedResponse.ProtoReflect.Descriptor instead.
+func (*TestGetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *TestGetFeedResponse) GetFeed() []string {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+type SetNodeNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SetNodeNameRequest) Reset() {
+	*x = SetNodeNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNodeNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeNameRequest) ProtoMessage() {}
+
+func (x *SetNodeNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeNameRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeNameRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SetNodeNameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetNodeNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetNodeNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetNodeNameResponse) Reset() {
+	*x = SetNodeNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNodeNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeNameResponse) ProtoMessage() {}
+
+func (x *SetNodeNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeNameResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeNameResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{147}
+}
+
+type GetSimilarPlacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Offset int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetSimilarPlacesRequest) Reset() {
+	*x = GetSimilarPlacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarPlacesRequest) ProtoMessage() {}
+
+func (x *GetSimilarPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarPlacesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetSimilarPlacesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetSimilarPlacesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetSimilarPlacesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSimilarPlacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *GetSimilarPlacesResponse) Reset() {
+	*x = GetSimilarPlacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSimilarPlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarPlacesResponse) ProtoMessage() {}
+
+func (x *GetSimilarPlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarPlacesResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarPlacesResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetSimilarPlacesResponse) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetLandmarkTagsWithScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLandmarkTagsWithScoreRequest) Reset() {
+	*x = GetLandmarkTagsWithScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarkTagsWithScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarkTagsWithScoreRequest) ProtoMessage() {}
+
+func (x *GetLandmarkTagsWithScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarkTagsWithScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetLandmarkTagsWithScoreRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetLandmarkTagsWithScoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TagIdScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagId string  `protobuf:"bytes,1,opt,name=tagId,proto3" json:"tagId,omitempty"`
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *TagIdScore) Reset() {
+	*x = TagIdScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagIdScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagIdScore) ProtoMessage() {}
+
+func (x *TagIdScore) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagIdScore.ProtoReflect.Descriptor instead.
+func (*TagIdScore) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *TagIdScore) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *TagIdScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetLandmarkTagsWithScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagIdScore `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetLandmarkTagsWithScoreResponse) Reset() {
+	*x = GetLandmarkTagsWithScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLandmarkTagsWithScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandmarkTagsWithScoreResponse) ProtoMessage() {}
+
+func (x *GetLandmarkTagsWithScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandmarkTagsWithScoreResponse.ProtoReflect.Descriptor instead.
+func (*GetLandmarkTagsWithScoreResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetLandmarkTagsWithScoreResponse) GetTags() []*TagIdScore {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetActivityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activity  string       `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	Northeast *Coordinates `protobuf:"bytes,2,opt,name=northeast,proto3" json:"northeast,omitempty"`
+	Southeast *Coordinates `protobuf:"bytes,3,opt,name=southeast,proto3" json:"southeast,omitempty"`
+	Limit     int32        `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset    int32        `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Include   []string     `protobuf:"bytes,6,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude   []string     `protobuf:"bytes,7,rep,name=exclude,proto3" json:"exclude,omitempty"`
+}
+
+func (x *GetActivityRequest) Reset() {
+	*x = GetActivityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityRequest) ProtoMessage() {}
+
+func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityRequest) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetActivityRequest) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *GetActivityRequest) GetNortheast() *Coordinates {
+	if x != nil {
+		return x.Northeast
+	}
+	return nil
+}
+
+func (x *GetActivityRequest) GetSoutheast() *Coordinates {
+	if x != nil {
+		return x.Southeast
+	}
+	return nil
+}
+
+func (x *GetActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetActivityRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetActivityRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetActivityRequest) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+type LandmarkItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score       float32  `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Latitude    float32  `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32  `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Tags        []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Latitude64  *float64 `protobuf:"fixed64,6,opt,name=latitude64,proto3,oneof" json:"latitude64,omitempty"`
+	Longitude64 *float64 `protobuf:"fixed64,7,opt,name=longitude64,proto3,oneof" json:"longitude64,omitempty"`
+}
+
+func (x *LandmarkItem) Reset() {
+	*x = LandmarkItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LandmarkItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandmarkItem) ProtoMessage() {}
+
+func (x *LandmarkItem) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandmarkItem.ProtoReflect.Descriptor instead.
+func (*LandmarkItem) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *LandmarkItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LandmarkItem) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LandmarkItem) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LandmarkItem) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *LandmarkItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *LandmarkItem) GetLatitude64() float64 {
+	if x != nil && x.Latitude64 != nil {
+		return *x.Latitude64
+	}
+	return 0
+}
+
+func (x *LandmarkItem) GetLongitude64() float64 {
+	if x != nil && x.Longitude64 != nil {
+		return *x.Longitude64
+	}
+	return 0
+}
+
+type GetActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*LandmarkItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetActivityResponse) Reset() {
+	*x = GetActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storage_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityResponse) ProtoMessage() {}
+
+func (x *GetActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storage_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetActivityResponse) Descriptor() ([]byte, []int) {
+	return file_storage_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetActivityResponse) GetItems() []*LandmarkItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_storage_proto protoreflect.FileDescriptor
+
+var file_storage_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x10, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x22, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x41, 0x64,
+	0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x23, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x22, 0x15, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49,
+	0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44,
+	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6b, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x19, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x22, 0x2e, 0x0a,
+	0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2c, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x4c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xab, 0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x65, 0x6c,
+	0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xa2, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x62, 0x61, 0x79, 0x65, 0x73, 0x69, 0x61, 0x6e, 0x4d, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x79, 0x65, 0x73, 0x69, 0x61, 0x6e, 0x4d, 0x65, 0x61, 0x6e,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x61, 0x6e, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x55, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x22, 0x3d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x7b, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x81, 0x01,
+	0x0a, 0x15, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x17, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x5e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x52, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x51, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x5f, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x50,
+	0x0a, 0x18, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x1b, 0x0a, 0x19, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xde,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a,
+	0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f,
+	0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x31, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x57, 0x0a, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6c, 0x6c, 0x75, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6c, 0x6c,
+	0x75, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22,
+	0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x32,
+	0x22, 0x2e, 0x0a, 0x10, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x22, 0x42, 0x0a, 0x14, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x32, 0x22, 0x29, 0x0a, 0x15, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x47, 0x0a, 0x19, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x32, 0x22, 0x32, 0x0a, 0x1a, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x11,
+	0x41, 0x72, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x41, 0x72, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x0d,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4e, 0x0a, 0x18, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x19, 0x53,
+	0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x58, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x53, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x22, 0x13,
+	0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x31, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x32, 0x22, 0x31, 0x0a, 0x11, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a,
+	0x10, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x74,
+	0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x5e, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x69, 0x64, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3b, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x31, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x32, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x63, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x61, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x67, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x45, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x41, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x22,
+	0x2d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42,
+	0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xf9,
+	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x1a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x69, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6f, 0x0a, 0x13, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x63, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46,
+	0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x4b, 0x0a, 0x11, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a,
+	0x12, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x4f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65,
+	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x71, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xe9, 0x01, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x22, 0x29, 0x0a, 0x13,
+	0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x54, 0x61, 0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x49, 0x64, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x54,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68,
+	0x65, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f, 0x72, 0x74, 0x68,
+	0x65, 0x61, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x74, 0x68, 0x65, 0x61, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36, 0x34, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x36,
+	0x34, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x36, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x36, 0x34, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x2a, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x48, 0x45, 0x4c, 0x50, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x02, 0x2a, 0xae, 0x01, 0x0a,
+	0x12, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x52, 0x49,
+	0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xa4, 0x3b,
+	0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x42,
+	0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x24, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c,
+	0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x25, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x0f, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6b, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c,
+	0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x25, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76,
+	0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x2c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x89, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x33,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x27,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x71, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5c, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x25,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x66,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x74, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x08, 0x49, 0x73, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73,
+	0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12,
+	0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a,
+	0x41, 0x72, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x72,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x72, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x76, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x77, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09,
+	0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x73, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x49, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67,
+	0x12, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x22, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61,
+	0x67, 0x12, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12,
+	0x26, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e,
+	0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x22, 0x2e, 0x6c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x54,
+	0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61,
+	0x67, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x2e, 0x6c, 0x61,
+	0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x61, 0x67,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x14, 0x5a, 0x12, 0x2e, 0x2f, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61,
+	0x72, 0x6b, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_storage_proto_rawDescOnce sync.Once
+	file_storage_proto_rawDescData = file_storage_proto_rawDesc
+)
+
+func file_storage_proto_rawDescGZIP() []byte {
+	file_storage_proto_rawDescOnce.Do(func() {
+		file_storage_proto_rawDescData = protoimpl.X.CompressGZIP(file_storage_proto_rawDescData)
+	})
+	return file_storage_proto_rawDescData
+}
+
+var file_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 156)
+var file_storage_proto_goTypes = []interface{}{
+	(ReactionKind)(0),                          // 0: landmark.storage.ReactionKind
+	(FriendRequestState)(0),                    // 1: landmark.storage.FriendRequestState
+	(*Coordinates)(nil),                        // 2: landmark.storage.Coordinates
+	(*GetLandmarkRequest)(nil),                 // 3: landmark.storage.GetLandmarkRequest
+	(*GetLandmarkResponse)(nil),                // 4: landmark.storage.GetLandmarkResponse
+	(*AddLandmarkRequest)(nil),                 // 5: landmark.storage.AddLandmarkRequest
+	(*AddLandmarkResponse)(nil),                // 6: landmark.storage.AddLandmarkResponse
+	(*LikeLandmarkRequest)(nil),                // 7: landmark.storage.LikeLandmarkRequest
+	(*LikeLandmarkResponse)(nil),               // 8: landmark.storage.LikeLandmarkResponse
+	(*DislikeLandmarkRequest)(nil),             // 9: landmark.storage.DislikeLandmarkRequest
+	(*DislikeLandmarkResponse)(nil),            // 10: landmark.storage.DislikeLandmarkResponse
+	(*GetLikesRequest)(nil),                    // 11: landmark.storage.GetLikesRequest
+	(*GetLikesResponse)(nil),                   // 12: landmark.storage.GetLikesResponse
+	(*ViewLandmarkRequest)(nil),                // 13: landmark.storage.ViewLandmarkRequest
+	(*ViewLandmarkResponse)(nil),               // 14: landmark.storage.ViewLandmarkResponse
+	(*RecommendLandmarksRequest)(nil),          // 15: landmark.storage.RecommendLandmarksRequest
+	(*RecommendLandmarksResponse)(nil),         // 16: landmark.storage.RecommendLandmarksResponse
+	(*GetRandomFeedRequest)(nil),               // 17: landmark.storage.GetRandomFeedRequest
+	(*GetRandomFeedResponse)(nil),              // 18: landmark.storage.GetRandomFeedResponse
+	(*AddUserRequest)(nil),                     // 19: landmark.storage.AddUserRequest
+	(*AddUserResponse)(nil),                    // 20: landmark.storage.AddUserResponse
+	(*CreateCommentRequest)(nil),               // 21: landmark.storage.CreateCommentRequest
+	(*CreateCommentResponse)(nil),              // 22: landmark.storage.CreateCommentResponse
+	(*DeleteCommentRequest)(nil),               // 23: landmark.storage.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),              // 24: landmark.storage.DeleteCommentResponse
+	(*EditCommentRequest)(nil),                 // 25: landmark.storage.EditCommentRequest
+	(*EditCommentResponse)(nil),                // 26: landmark.storage.EditCommentResponse
+	(*Comment)(nil),                            // 27: landmark.storage.Comment
+	(*GetCommentsRequest)(nil),                 // 28: landmark.storage.GetCommentsRequest
+	(*GetCommentsResponse)(nil),                // 29: landmark.storage.GetCommentsResponse
+	(*RatingSummary)(nil),                      // 30: landmark.storage.RatingSummary
+	(*GetRatingSummaryRequest)(nil),            // 31: landmark.storage.GetRatingSummaryRequest
+	(*GetRatingSummaryResponse)(nil),           // 32: landmark.storage.GetRatingSummaryResponse
+	(*GetRatingSummariesRequest)(nil),          // 33: landmark.storage.GetRatingSummariesRequest
+	(*GetRatingSummariesResponse)(nil),         // 34: landmark.storage.GetRatingSummariesResponse
+	(*CommentReaction)(nil),                    // 35: landmark.storage.CommentReaction
+	(*ReactToCommentRequest)(nil),              // 36: landmark.storage.ReactToCommentRequest
+	(*ReactToCommentResponse)(nil),             // 37: landmark.storage.ReactToCommentResponse
+	(*UnreactToCommentRequest)(nil),            // 38: landmark.storage.UnreactToCommentRequest
+	(*UnreactToCommentResponse)(nil),           // 39: landmark.storage.UnreactToCommentResponse
+	(*GetCommentReactionsRequest)(nil),         // 40: landmark.storage.GetCommentReactionsRequest
+	(*GetCommentReactionsResponse)(nil),        // 41: landmark.storage.GetCommentReactionsResponse
+	(*UploadAttachmentRequest)(nil),            // 42: landmark.storage.UploadAttachmentRequest
+	(*AttachmentInfo)(nil),                     // 43: landmark.storage.AttachmentInfo
+	(*UploadAttachmentResponse)(nil),           // 44: landmark.storage.UploadAttachmentResponse
+	(*AttachmentMeta)(nil),                     // 45: landmark.storage.AttachmentMeta
+	(*GetAttachmentMetaRequest)(nil),           // 46: landmark.storage.GetAttachmentMetaRequest
+	(*GetAttachmentMetaResponse)(nil),          // 47: landmark.storage.GetAttachmentMetaResponse
+	(*CommentRevision)(nil),                    // 48: landmark.storage.CommentRevision
+	(*GetCommentHistoryRequest)(nil),           // 49: landmark.storage.GetCommentHistoryRequest
+	(*GetCommentHistoryResponse)(nil),          // 50: landmark.storage.GetCommentHistoryResponse
+	(*SoftDeleteCommentRequest)(nil),           // 51: landmark.storage.SoftDeleteCommentRequest
+	(*SoftDeleteCommentResponse)(nil),          // 52: landmark.storage.SoftDeleteCommentResponse
+	(*RestoreCommentRequest)(nil),              // 53: landmark.storage.RestoreCommentRequest
+	(*RestoreCommentResponse)(nil),             // 54: landmark.storage.RestoreCommentResponse
+	(*GetProfileCommentsRequest)(nil),          // 55: landmark.storage.GetProfileCommentsRequest
+	(*GetProfileCommentsResponse)(nil),         // 56: landmark.storage.GetProfileCommentsResponse
+	(*GetFavouriteLandmarksRequest)(nil),       // 57: landmark.storage.GetFavouriteLandmarksRequest
+	(*GetFavouriteLandmarksResponse)(nil),      // 58: landmark.storage.GetFavouriteLandmarksResponse
+	(*GetLikesAmountRequest)(nil),              // 59: landmark.storage.GetLikesAmountRequest
+	(*GetLikesAmountResponse)(nil),             // 60: landmark.storage.GetLikesAmountResponse
+	(*GetUserTagsRequest)(nil),                 // 61: landmark.storage.GetUserTagsRequest
+	(*GetUserTagsResponse)(nil),                // 62: landmark.storage.GetUserTagsResponse
+	(*GetFeaturesTopicsRequest)(nil),           // 63: landmark.storage.GetFeaturesTopicsRequest
+	(*FeaturedTopic)(nil),                      // 64: landmark.storage.FeaturedTopic
+	(*GetFeaturedTopicsResponse)(nil),          // 65: landmark.storage.GetFeaturedTopicsResponse
+	(*AddFriendRequest)(nil),                   // 66: landmark.storage.AddFriendRequest
+	(*AddFriendResponse)(nil),                  // 67: landmark.storage.AddFriendResponse
+	(*DeleteFriendRequest)(nil),                // 68: landmark.storage.DeleteFriendRequest
+	(*DeleteFriendResponse)(nil),               // 69: landmark.storage.DeleteFriendResponse
+	(*GetFriendsRequest)(nil),                  // 70: landmark.storage.GetFriendsRequest
+	(*GetFriendsResponse)(nil),                 // 71: landmark.storage.GetFriendsResponse
+	(*CountFriendsRequest)(nil),                // 72: landmark.storage.CountFriendsRequest
+	(*CountFriendsResponse)(nil),               // 73: landmark.storage.CountFriendsResponse
+	(*IsFriendRequest)(nil),                    // 74: landmark.storage.IsFriendRequest
+	(*IsFriendResponse)(nil),                   // 75: landmark.storage.IsFriendResponse
+	(*MutualFriendsRequest)(nil),               // 76: landmark.storage.MutualFriendsRequest
+	(*MutualFriendsResponse)(nil),              // 77: landmark.storage.MutualFriendsResponse
+	(*CountMutualFriendsRequest)(nil),          // 78: landmark.storage.CountMutualFriendsRequest
+	(*CountMutualFriendsResponse)(nil),         // 79: landmark.storage.CountMutualFriendsResponse
+	(*AreFriendsRequest)(nil),                  // 80: landmark.storage.AreFriendsRequest
+	(*AreFriendsResponse)(nil),                 // 81: landmark.storage.AreFriendsResponse
+	(*FriendRequest)(nil),                      // 82: landmark.storage.FriendRequest
+	(*SendFriendRequestRequest)(nil),           // 83: landmark.storage.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),          // 84: landmark.storage.SendFriendRequestResponse
+	(*GetFriendRequestsRequest)(nil),           // 85: landmark.storage.GetFriendRequestsRequest
+	(*GetFriendRequestsResponse)(nil),          // 86: landmark.storage.GetFriendRequestsResponse
+	(*RespondFriendRequestRequest)(nil),        // 87: landmark.storage.RespondFriendRequestRequest
+	(*RespondFriendRequestResponse)(nil),       // 88: landmark.storage.RespondFriendRequestResponse
+	(*BlockUserRequest)(nil),                   // 89: landmark.storage.BlockUserRequest
+	(*BlockUserResponse)(nil),                  // 90: landmark.storage.BlockUserResponse
+	(*GetBlockedUsersRequest)(nil),             // 91: landmark.storage.GetBlockedUsersRequest
+	(*GetBlockedUsersResponse)(nil),            // 92: landmark.storage.GetBlockedUsersResponse
+	(*IsBlockedRequest)(nil),                   // 93: landmark.storage.IsBlockedRequest
+	(*IsBlockedResponse)(nil),                  // 94: landmark.storage.IsBlockedResponse
+	(*SuggestFriendsRequest)(nil),              // 95: landmark.storage.SuggestFriendsRequest
+	(*FriendSuggestion)(nil),                   // 96: landmark.storage.FriendSuggestion
+	(*SuggestFriendsResponse)(nil),             // 97: landmark.storage.SuggestFriendsResponse
+	(*CountReviewsRequest)(nil),                // 98: landmark.storage.CountReviewsRequest
+	(*CountReviewsResponse)(nil),               // 99: landmark.storage.CountReviewsResponse
+	(*CreateTagRequest)(nil),                   // 100: landmark.storage.CreateTagRequest
+	(*CreateTagResponse)(nil),                  // 101: landmark.storage.CreateTagResponse
+	(*ConnectTagsRequest)(nil),                 // 102: landmark.storage.ConnectTagsRequest
+	(*ConnectTagsResponse)(nil),                // 103: landmark.storage.ConnectTagsResponse
+	(*DisconnectTagsRequest)(nil),              // 104: landmark.storage.DisconnectTagsRequest
+	(*DisconnectTagsResponse)(nil),             // 105: landmark.storage.DisconnectTagsResponse
+	(*DeleteTagRequest)(nil),                   // 106: landmark.storage.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                  // 107: landmark.storage.DeleteTagResponse
+	(*AddLandmarkTagRequest)(nil),              // 108: landmark.storage.AddLandmarkTagRequest
+	(*AddLandmarkTagResponse)(nil),             // 109: landmark.storage.AddLandmarkTagResponse
+	(*RemoveLandmarkTagRequest)(nil),           // 110: landmark.storage.RemoveLandmarkTagRequest
+	(*RemoveLandmarkTagResponse)(nil),          // 111: landmark.storage.RemoveLandmarkTagResponse
+	(*GetLandmarkTagsRequest)(nil),             // 112: landmark.storage.GetLandmarkTagsRequest
+	(*GetLandmarkTagsResponse)(nil),            // 113: landmark.storage.GetLandmarkTagsResponse
+	(*GetConnectedTagsRequest)(nil),            // 114: landmark.storage.GetConnectedTagsRequest
+	(*Tag)(nil),                                // 115: landmark.storage.Tag
+	(*GetConnectedTagsResponse)(nil),           // 116: landmark.storage.GetConnectedTagsResponse
+	(*SetUserTagRequest)(nil),                  // 117: landmark.storage.SetUserTagRequest
+	(*SetUserTagResponse)(nil),                 // 118: landmark.storage.SetUserTagResponse
+	(*DeleteUserTagRequest)(nil),               // 119: landmark.storage.DeleteUserTagRequest
+	(*DeleteUserTagResponse)(nil),              // 120: landmark.storage.DeleteUserTagResponse
+	(*GetLandmarksByTagRequest)(nil),           // 121: landmark.storage.GetLandmarksByTagRequest
+	(*GetLandmarksByTagResponse)(nil),          // 122: landmark.storage.GetLandmarksByTagResponse
+	(*GetLandmarksFilteredRequest)(nil),        // 123: landmark.storage.GetLandmarksFilteredRequest
+	(*GetLandmarksFilteredResponse)(nil),       // 124: landmark.storage.GetLandmarksFilteredResponse
+	(*UpdateLandmarkScoreRequest)(nil),         // 125: landmark.storage.UpdateLandmarkScoreRequest
+	(*UpdateLandmarkScoreResponse)(nil),        // 126: landmark.storage.UpdateLandmarkScoreResponse
+	(*GetRecentFriendsFavouritesRequest)(nil),  // 127: landmark.storage.GetRecentFriendsFavouritesRequest
+	(*FriendLikedLandmark)(nil),                // 128: landmark.storage.FriendLikedLandmark
+	(*GetRecentFriendsFavouritesResponse)(nil), // 129: landmark.storage.GetRecentFriendsFavouritesResponse
+	(*IsReviewedRequest)(nil),                  // 130: landmark.storage.IsReviewedRequest
+	(*IsReviewedResponse)(nil),                 // 131: landmark.storage.IsReviewedResponse
+	(*GetReviewRequest)(nil),                   // 132: landmark.storage.GetReviewRequest
+	(*GetReviewResponse)(nil),                  // 133: landmark.storage.GetReviewResponse
+	(*SetLandmarkScoreRequest)(nil),            // 134: landmark.storage.SetLandmarkScoreRequest
+	(*SetLandmarkScoreResponse)(nil),           // 135: landmark.storage.SetLandmarkScoreResponse
+	(*SetMultipleViewedRequest)(nil),           // 136: landmark.storage.SetMultipleViewedRequest
+	(*SetMultipleViewedResponse)(nil),          // 137: landmark.storage.SetMultipleViewedResponse
+	(*NotInterestedRequest)(nil),               // 138: landmark.storage.NotInterestedRequest
+	(*NotInterestedResponse)(nil),              // 139: landmark.storage.NotInterestedResponse
+	(*ChangeUserTagsRequest)(nil),              // 140: landmark.storage.ChangeUserTagsRequest
+	(*ChangeUserTagsResponse)(nil),             // 141: landmark.storage.ChangeUserTagsResponse
+	(*DeleteLandmarkRequest)(nil),              // 142: landmark.storage.DeleteLandmarkRequest
+	(*DeleteLandmarkResponse)(nil),             // 143: landmark.storage.DeleteLandmarkResponse
+	(*SetLandmarkCoordsRequest)(nil),           // 144: landmark.storage.SetLandmarkCoordsRequest
+	(*SetLandmarkCoordsResponse)(nil),          // 145: landmark.storage.SetLandmarkCoordsResponse
+	(*TestGetFeedRequest)(nil),                 // 146: landmark.storage.TestGetFeedRequest
+	(*TestGetFeedResponse)(nil),                // 147: landmark.storage.TestGetFeedResponse
+	(*SetNodeNameRequest)(nil),                 // 148: landmark.storage.SetNodeNameRequest
+	(*SetNodeNameResponse)(nil),                // 149: landmark.storage.SetNodeNameResponse
+	(*GetSimilarPlacesRequest)(nil),            // 150: landmark.storage.GetSimilarPlacesRequest
+	(*GetSimilarPlacesResponse)(nil),           // 151: landmark.storage.GetSimilarPlacesResponse
+	(*GetLandmarkTagsWithScoreRequest)(nil),    // 152: landmark.storage.GetLandmarkTagsWithScoreRequest
+	(*TagIdScore)(nil),                         // 153: landmark.storage.TagIdScore
+	(*GetLandmarkTagsWithScoreResponse)(nil),   // 154: landmark.storage.GetLandmarkTagsWithScoreResponse
+	(*GetActivityRequest)(nil),                 // 155: landmark.storage.GetActivityRequest
+	(*LandmarkItem)(nil),                       // 156: landmark.storage.LandmarkItem
+	(*GetActivityResponse)(nil),                // 157: landmark.storage.GetActivityResponse
+}
+var file_storage_proto_depIdxs = []int32{
+	0,   // 0: landmark.storage.Comment.userReaction:type_name -> landmark.storage.ReactionKind
+	27,  // 1: landmark.storage.GetCommentsResponse.comments:type_name -> landmark.storage.Comment
+	30,  // 2: landmark.storage.GetRatingSummaryResponse.summary:type_name -> landmark.storage.RatingSummary
+	30,  // 3: landmark.storage.GetRatingSummariesResponse.summaries:type_name -> landmark.storage.RatingSummary
+	0,   // 4: landmark.storage.CommentReaction.kind:type_name -> landmark.storage.ReactionKind
+	0,   // 5: landmark.storage.ReactToCommentRequest.kind:type_name -> landmark.storage.ReactionKind
+	35,  // 6: landmark.storage.GetCommentReactionsResponse.reactions:type_name -> landmark.storage.CommentReaction
+	43,  // 7: landmark.storage.UploadAttachmentRequest.info:type_name -> landmark.storage.AttachmentInfo
+	45,  // 8: landmark.storage.GetAttachmentMetaResponse.meta:type_name -> landmark.storage.AttachmentMeta
+	48,  // 9: landmark.storage.GetCommentHistoryResponse.revisions:type_name -> landmark.storage.CommentRevision
+	27,  // 10: landmark.storage.GetProfileCommentsResponse.comments:type_name -> landmark.storage.Comment
+	2,   // 11: landmark.storage.GetFavouriteLandmarksRequest.northeast:type_name -> landmark.storage.Coordinates
+	2,   // 12: landmark.storage.GetFavouriteLandmarksRequest.southeast:type_name -> landmark.storage.Coordinates
+	64,  // 13: landmark.storage.GetFeaturedTopicsResponse.topics:type_name -> landmark.storage.FeaturedTopic
+	1,   // 14: landmark.storage.FriendRequest.state:type_name -> landmark.storage.FriendRequestState
+	82,  // 15: landmark.storage.SendFriendRequestResponse.request:type_name -> landmark.storage.FriendRequest
+	82,  // 16: landmark.storage.GetFriendRequestsResponse.requests:type_name -> landmark.storage.FriendRequest
+	96,  // 17: landmark.storage.SuggestFriendsResponse.suggestions:type_name -> landmark.storage.FriendSuggestion
+	115, // 18: landmark.storage.GetConnectedTagsResponse.tags:type_name -> landmark.storage.Tag
+	2,   // 19: landmark.storage.GetLandmarksByTagRequest.northeast:type_name -> landmark.storage.Coordinates
+	2,   // 20: landmark.storage.GetLandmarksByTagRequest.southeast:type_name -> landmark.storage.Coordinates
+	2,   // 21: landmark.storage.GetLandmarksFilteredRequest.northeast:type_name -> landmark.storage.Coordinates
+	2,   // 22: landmark.storage.GetLandmarksFilteredRequest.southeast:type_name -> landmark.storage.Coordinates
+	128, // 23: landmark.storage.GetRecentFriendsFavouritesResponse.result:type_name -> landmark.storage.FriendLikedLandmark
+	27,  // 24: landmark.storage.GetReviewResponse.review:type_name -> landmark.storage.Comment
+	2,   // 25: landmark.storage.SetLandmarkCoordsRequest.coords:type_name -> landmark.storage.Coordinates
+	153, // 26: landmark.storage.GetLandmarkTagsWithScoreResponse.tags:type_name -> landmark.storage.TagIdScore
+	2,   // 27: landmark.storage.GetActivityRequest.northeast:type_name -> landmark.storage.Coordinates
+	2,   // 28: landmark.storage.GetActivityRequest.southeast:type_name -> landmark.storage.Coordinates
+	156, // 29: landmark.storage.GetActivityResponse.items:type_name -> landmark.storage.LandmarkItem
+	3,   // 30: landmark.storage.StorageService.GetLandmark:input_type -> landmark.storage.GetLandmarkRequest
+	121, // 31: landmark.storage.StorageService.GetLandmarksByTag:input_type -> landmark.storage.GetLandmarksByTagRequest
+	5,   // 32: landmark.storage.StorageService.AddLandmark:input_type -> landmark.storage.AddLandmarkRequest
+	7,   // 33: landmark.storage.StorageService.LikeLandmark:input_type -> landmark.storage.LikeLandmarkRequest
+	9,   // 34: landmark.storage.StorageService.DislikeLandmark:input_type -> landmark.storage.DislikeLandmarkRequest
+	11,  // 35: landmark.storage.StorageService.GetLikes:input_type -> landmark.storage.GetLikesRequest
+	13,  // 36: landmark.storage.StorageService.ViewLandmark:input_type -> landmark.storage.ViewLandmarkRequest
+	57,  // 37: landmark.storage.StorageService.GetFavouriteLandmarks:input_type -> landmark.storage.GetFavouriteLandmarksRequest
+	59,  // 38: landmark.storage.StorageService.GetLikesAmount:input_type -> landmark.storage.GetLikesAmountRequest
+	123, // 39: landmark.storage.StorageService.GetLandmarksFiltered:input_type -> landmark.storage.GetLandmarksFilteredRequest
+	125, // 40: landmark.storage.StorageService.UpdateLandmarkScore:input_type -> landmark.storage.UpdateLandmarkScoreRequest
+	127, // 41: landmark.storage.StorageService.GetRecentFriendsFavourites:input_type -> landmark.storage.GetRecentFriendsFavouritesRequest
+	134, // 42: landmark.storage.StorageService.SetLandmarkScore:input_type -> landmark.storage.SetLandmarkScoreRequest
+	138, // 43: landmark.storage.StorageService.NotInterested:input_type -> landmark.storage.NotInterestedRequest
+	142, // 44: landmark.storage.StorageService.DeleteLandmark:input_type -> landmark.storage.DeleteLandmarkRequest
+	144, // 45: landmark.storage.StorageService.SetLandmarkCoords:input_type -> landmark.storage.SetLandmarkCoordsRequest
+	155, // 46: landmark.storage.StorageService.GetActivity:input_type -> landmark.storage.GetActivityRequest
+	15,  // 47: landmark.storage.StorageService.RecommendLandmarks:input_type -> landmark.storage.RecommendLandmarksRequest
+	17,  // 48: landmark.storage.StorageService.GetRandomFeed:input_type -> landmark.storage.GetRandomFeedRequest
+	150, // 49: landmark.storage.StorageService.GetSimilarPlaces:input_type -> landmark.storage.GetSimilarPlacesRequest
+	19,  // 50: landmark.storage.StorageService.AddUser:input_type -> landmark.storage.AddUserRequest
+	21,  // 51: landmark.storage.StorageService.CreateComment:input_type -> landmark.storage.CreateCommentRequest
+	23,  // 52: landmark.storage.StorageService.DeleteComment:input_type -> landmark.storage.DeleteCommentRequest
+	25,  // 53: landmark.storage.StorageService.EditComment:input_type -> landmark.storage.EditCommentRequest
+	28,  // 54: landmark.storage.StorageService.GetComments:input_type -> landmark.storage.GetCommentsRequest
+	55,  // 55: landmark.storage.StorageService.GetProfileComments:input_type -> landmark.storage.GetProfileCommentsRequest
+	98,  // 56: landmark.storage.StorageService.CountReviews:input_type -> landmark.storage.CountReviewsRequest
+	130, // 57: landmark.storage.StorageService.IsReviewedBy:input_type -> landmark.storage.IsReviewedRequest
+	132, // 58: landmark.storage.StorageService.GetReview:input_type -> landmark.storage.GetReviewRequest
+	49,  // 59: landmark.storage.StorageService.GetCommentHistory:input_type -> landmark.storage.GetCommentHistoryRequest
+	51,  // 60: landmark.storage.StorageService.SoftDeleteComment:input_type -> landmark.storage.SoftDeleteCommentRequest
+	53,  // 61: landmark.storage.StorageService.RestoreComment:input_type -> landmark.storage.RestoreCommentRequest
+	36,  // 62: landmark.storage.StorageService.ReactToComment:input_type -> landmark.storage.ReactToCommentRequest
+	38,  // 63: landmark.storage.StorageService.UnreactToComment:input_type -> landmark.storage.UnreactToCommentRequest
+	40,  // 64: landmark.storage.StorageService.GetCommentReactions:input_type -> landmark.storage.GetCommentReactionsRequest
+	42,  // 65: landmark.storage.StorageService.UploadAttachment:input_type -> landmark.storage.UploadAttachmentRequest
+	46,  // 66: landmark.storage.StorageService.GetAttachmentMeta:input_type -> landmark.storage.GetAttachmentMetaRequest
+	31,  // 67: landmark.storage.StorageService.GetRatingSummary:input_type -> landmark.storage.GetRatingSummaryRequest
+	33,  // 68: landmark.storage.StorageService.GetRatingSummaries:input_type -> landmark.storage.GetRatingSummariesRequest
+	66,  // 69: landmark.storage.StorageService.AddFriend:input_type -> landmark.storage.AddFriendRequest
+	68,  // 70: landmark.storage.StorageService.DeleteFriend:input_type -> landmark.storage.DeleteFriendRequest
+	70,  // 71: landmark.storage.StorageService.GetFriends:input_type -> landmark.storage.GetFriendsRequest
+	72,  // 72: landmark.storage.StorageService.CountFriends:input_type -> landmark.storage.CountFriendsRequest
+	74,  // 73: landmark.storage.StorageService.IsFriend:input_type -> landmark.storage.IsFriendRequest
+	95,  // 74: landmark.storage.StorageService.SuggestFriends:input_type -> landmark.storage.SuggestFriendsRequest
+	76,  // 75: landmark.storage.StorageService.MutualFriends:input_type -> landmark.storage.MutualFriendsRequest
+	78,  // 76: landmark.storage.StorageService.CountMutualFriends:input_type -> landmark.storage.CountMutualFriendsRequest
+	80,  // 77: landmark.storage.StorageService.AreFriends:input_type -> landmark.storage.AreFriendsRequest
+	83,  // 78: landmark.storage.StorageService.SendFriendRequest:input_type -> landmark.storage.SendFriendRequestRequest
+	85,  // 79: landmark.storage.StorageService.GetIncomingFriendRequests:input_type -> landmark.storage.GetFriendRequestsRequest
+	85,  // 80: landmark.storage.StorageService.GetOutgoingFriendRequests:input_type -> landmark.storage.GetFriendRequestsRequest
+	87,  // 81: landmark.storage.StorageService.AcceptFriendRequest:input_type -> landmark.storage.RespondFriendRequestRequest
+	87,  // 82: landmark.storage.StorageService.DeclineFriendRequest:input_type -> landmark.storage.RespondFriendRequestRequest
+	87,  // 83: landmark.storage.StorageService.CancelFriendRequest:input_type -> landmark.storage.RespondFriendRequestRequest
+	89,  // 84: landmark.storage.StorageService.BlockUser:input_type -> landmark.storage.BlockUserRequest
+	89,  // 85: landmark.storage.StorageService.UnblockUser:input_type -> landmark.storage.BlockUserRequest
+	91,  // 86: landmark.storage.StorageService.GetBlockedUsers:input_type -> landmark.storage.GetBlockedUsersRequest
+	93,  // 87: landmark.storage.StorageService.IsBlocked:input_type -> landmark.storage.IsBlockedRequest
+	108, // 88: landmark.storage.StorageService.AddLandmarkTag:input_type -> landmark.storage.AddLandmarkTagRequest
+	110, // 89: landmark.storage.StorageService.RemoveLandmarkTag:input_type -> landmark.storage.RemoveLandmarkTagRequest
+	100, // 90: landmark.storage.StorageService.CreateTag:input_type -> landmark.storage.CreateTagRequest
+	117, // 91: landmark.storage.StorageService.SetUserTag:input_type -> landmark.storage.SetUserTagRequest
+	119, // 92: landmark.storage.StorageService.DeleteUserTag:input_type -> landmark.storage.DeleteUserTagRequest
+	61,  // 93: landmark.storage.StorageService.GetUserTags:input_type -> landmark.storage.GetUserTagsRequest
+	112, // 94: landmark.storage.StorageService.GetLandmarkTags:input_type -> landmark.storage.GetLandmarkTagsRequest
+	102, // 95: landmark.storage.StorageService.ConnectTags:input_type -> landmark.storage.ConnectTagsRequest
+	104, // 96: landmark.storage.StorageService.DisconnectTags:input_type -> landmark.storage.DisconnectTagsRequest
+	106, // 97: landmark.storage.StorageService.DeleteTag:input_type -> landmark.storage.DeleteTagRequest
+	114, // 98: landmark.storage.StorageService.GetConnectedTags:input_type -> landmark.storage.GetConnectedTagsRequest
+	140, // 99: landmark.storage.StorageService.ChangeUserTags:input_type -> landmark.storage.ChangeUserTagsRequest
+	146, // 100: landmark.storage.StorageService.TestGetRecommended:input_type -> landmark.storage.TestGetFeedRequest
+	148, // 101: landmark.storage.StorageService.SetNodeName:input_type -> landmark.storage.SetNodeNameRequest
+	152, // 102: landmark.storage.StorageService.GetLandmarkTagsWithScore:input_type -> landmark.storage.GetLandmarkTagsWithScoreRequest
+	4,   // 103: landmark.storage.StorageService.GetLandmark:output_type -> landmark.storage.GetLandmarkResponse
+	122, // 104: landmark.storage.StorageService.GetLandmarksByTag:output_type -> landmark.storage.GetLandmarksByTagResponse
+	6,   // 105: landmark.storage.StorageService.AddLandmark:output_type -> landmark.storage.AddLandmarkResponse
+	8,   // 106: landmark.storage.StorageService.LikeLandmark:output_type -> landmark.storage.LikeLandmarkResponse
+	10,  // 107: landmark.storage.StorageService.DislikeLandmark:output_type -> landmark.storage.DislikeLandmarkResponse
+	12,  // 108: landmark.storage.StorageService.GetLikes:output_type -> landmark.storage.GetLikesResponse
+	14,  // 109: landmark.storage.StorageService.ViewLandmark:output_type -> landmark.storage.ViewLandmarkResponse
+	58,  // 110: landmark.storage.StorageService.GetFavouriteLandmarks:output_type -> landmark.storage.GetFavouriteLandmarksResponse
+	60,  // 111: landmark.storage.StorageService.GetLikesAmount:output_type -> landmark.storage.GetLikesAmountResponse
+	124, // 112: landmark.storage.StorageService.GetLandmarksFiltered:output_type -> landmark.storage.GetLandmarksFilteredResponse
+	126, // 113: landmark.storage.StorageService.UpdateLandmarkScore:output_type -> landmark.storage.UpdateLandmarkScoreResponse
+	129, // 114: landmark.storage.StorageService.GetRecentFriendsFavourites:output_type -> landmark.storage.GetRecentFriendsFavouritesResponse
+	135, // 115: landmark.storage.StorageService.SetLandmarkScore:output_type -> landmark.storage.SetLandmarkScoreResponse
+	139, // 116: landmark.storage.StorageService.NotInterested:output_type -> landmark.storage.NotInterestedResponse
+	143, // 117: landmark.storage.StorageService.DeleteLandmark:output_type -> landmark.storage.DeleteLandmarkResponse
+	145, // 118: landmark.storage.StorageService.SetLandmarkCoords:output_type -> landmark.storage.SetLandmarkCoordsResponse
+	157, // 119: landmark.storage.StorageService.GetActivity:output_type -> landmark.storage.GetActivityResponse
+	16,  // 120: landmark.storage.StorageService.RecommendLandmarks:output_type -> landmark.storage.RecommendLandmarksResponse
+	18,  // 121: landmark.storage.StorageService.GetRandomFeed:output_type -> landmark.storage.GetRandomFeedResponse
+	151, // 122: landmark.storage.StorageService.GetSimilarPlaces:output_type -> landmark.storage.GetSimilarPlacesResponse
+	20,  // 123: landmark.storage.StorageService.AddUser:output_type -> landmark.storage.AddUserResponse
+	22,  // 124: landmark.storage.StorageService.CreateComment:output_type -> landmark.storage.CreateCommentResponse
+	24,  // 125: landmark.storage.StorageService.DeleteComment:output_type -> landmark.storage.DeleteCommentResponse
+	26,  // 126: landmark.storage.StorageService.EditComment:output_type -> landmark.storage.EditCommentResponse
+	29,  // 127: landmark.storage.StorageService.GetComments:output_type -> landmark.storage.GetCommentsResponse
+	56,  // 128: landmark.storage.StorageService.GetProfileComments:output_type -> landmark.storage.GetProfileCommentsResponse
+	99,  // 129: landmark.storage.StorageService.CountReviews:output_type -> landmark.storage.CountReviewsResponse
+	131, // 130: landmark.storage.StorageService.IsReviewedBy:output_type -> landmark.storage.IsReviewedResponse
+	133, // 131: landmark.storage.StorageService.GetReview:output_type -> landmark.storage.GetReviewResponse
+	50,  // 132: landmark.storage.StorageService.GetCommentHistory:output_type -> landmark.storage.GetCommentHistoryResponse
+	52,  // 133: landmark.storage.StorageService.SoftDeleteComment:output_type -> landmark.storage.SoftDeleteCommentResponse
+	54,  // 134: landmark.storage.StorageService.RestoreComment:output_type -> landmark.storage.RestoreCommentResponse
+	37,  // 135: landmark.storage.StorageService.ReactToComment:output_type -> landmark.storage.ReactToCommentResponse
+	39,  // 136: landmark.storage.StorageService.UnreactToComment:output_type -> landmark.storage.UnreactToCommentResponse
+	41,  // 137: landmark.storage.StorageService.GetCommentReactions:output_type -> landmark.storage.GetCommentReactionsResponse
+	44,  // 138: landmark.storage.StorageService.UploadAttachment:output_type -> landmark.storage.UploadAttachmentResponse
+	47,  // 139: landmark.storage.StorageService.GetAttachmentMeta:output_type -> landmark.storage.GetAttachmentMetaResponse
+	32,  // 140: landmark.storage.StorageService.GetRatingSummary:output_type -> landmark.storage.GetRatingSummaryResponse
+	34,  // 141: landmark.storage.StorageService.GetRatingSummaries:output_type -> landmark.storage.GetRatingSummariesResponse
+	67,  // 142: landmark.storage.StorageService.AddFriend:output_type -> landmark.storage.AddFriendResponse
+	69,  // 143: landmark.storage.StorageService.DeleteFriend:output_type -> landmark.storage.DeleteFriendResponse
+	71,  // 144: landmark.storage.StorageService.GetFriends:output_type -> landmark.storage.GetFriendsResponse
+	73,  // 145: landmark.storage.StorageService.CountFriends:output_type -> landmark.storage.CountFriendsResponse
+	75,  // 146: landmark.storage.StorageService.IsFriend:output_type -> landmark.storage.IsFriendResponse
+	97,  // 147: landmark.storage.StorageService.SuggestFriends:output_type -> landmark.storage.SuggestFriendsResponse
+	77,  // 148: landmark.storage.StorageService.MutualFriends:output_type -> landmark.storage.MutualFriendsResponse
+	79,  // 149: landmark.storage.StorageService.CountMutualFriends:output_type -> landmark.storage.CountMutualFriendsResponse
+	81,  // 150: landmark.storage.StorageService.AreFriends:output_type -> landmark.storage.AreFriendsResponse
+	84,  // 151: landmark.storage.StorageService.SendFriendRequest:output_type -> landmark.storage.SendFriendRequestResponse
+	86,  // 152: landmark.storage.StorageService.GetIncomingFriendRequests:output_type -> landmark.storage.GetFriendRequestsResponse
+	86,  // 153: landmark.storage.StorageService.GetOutgoingFriendRequests:output_type -> landmark.storage.GetFriendRequestsResponse
+	88,  // 154: landmark.storage.StorageService.AcceptFriendRequest:output_type -> landmark.storage.RespondFriendRequestResponse
+	88,  // 155: landmark.storage.StorageService.DeclineFriendRequest:output_type -> landmark.storage.RespondFriendRequestResponse
+	88,  // 156: landmark.storage.StorageService.CancelFriendRequest:output_type -> landmark.storage.RespondFriendRequestResponse
+	90,  // 157: landmark.storage.StorageService.BlockUser:output_type -> landmark.storage.BlockUserResponse
+	90,  // 158: landmark.storage.StorageService.UnblockUser:output_type -> landmark.storage.BlockUserResponse
+	92,  // 159: landmark.storage.StorageService.GetBlockedUsers:output_type -> landmark.storage.GetBlockedUsersResponse
+	94,  // 160: landmark.storage.StorageService.IsBlocked:output_type -> landmark.storage.IsBlockedResponse
+	109, // 161: landmark.storage.StorageService.AddLandmarkTag:output_type -> landmark.storage.AddLandmarkTagResponse
+	111, // 162: landmark.storage.StorageService.RemoveLandmarkTag:output_type -> landmark.storage.RemoveLandmarkTagResponse
+	101, // 163: landmark.storage.StorageService.CreateTag:output_type -> landmark.storage.CreateTagResponse
+	118, // 164: landmark.storage.StorageService.SetUserTag:output_type -> landmark.storage.SetUserTagResponse
+	120, // 165: landmark.storage.StorageService.DeleteUserTag:output_type -> landmark.storage.DeleteUserTagResponse
+	62,  // 166: landmark.storage.StorageService.GetUserTags:output_type -> landmark.storage.GetUserTagsResponse
+	113, // 167: landmark.storage.StorageService.GetLandmarkTags:output_type -> landmark.storage.GetLandmarkTagsResponse
+	103, // 168: landmark.storage.StorageService.ConnectTags:output_type -> landmark.storage.ConnectTagsResponse
+	105, // 169: landmark.storage.StorageService.DisconnectTags:output_type -> landmark.storage.DisconnectTagsResponse
+	107, // 170: landmark.storage.StorageService.DeleteTag:output_type -> landmark.storage.DeleteTagResponse
+	116, // 171: landmark.storage.StorageService.GetConnectedTags:output_type -> landmark.storage.GetConnectedTagsResponse
+	141, // 172: landmark.storage.StorageService.ChangeUserTags:output_type -> landmark.storage.ChangeUserTagsResponse
+	147, // 173: landmark.storage.StorageService.TestGetRecommended:output_type -> landmark.storage.TestGetFeedResponse
+	149, // 174: landmark.storage.StorageService.SetNodeName:output_type -> landmark.storage.SetNodeNameResponse
+	154, // 175: landmark.storage.StorageService.GetLandmarkTagsWithScore:output_type -> landmark.storage.GetLandmarkTagsWithScoreResponse
+	103, // [103:176] is the sub-list for method output_type
+	30,  // [30:103] is the sub-list for method input_type
+	30,  // [30:30] is the sub-list for extension type_name
+	30,  // [30:30] is the sub-list for extension extendee
+	0,   // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_storage_proto_init() }
+func file_storage_proto_init() {
+	if File_storage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLandmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLandmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLandmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLandmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeLandmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeLandmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeLandmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeLandmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewLandmarkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewLandmarkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendLandmarksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendLandmarksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRandomFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRandomFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingSummariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingSummariesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentReaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactToCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactToCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactToCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreactToCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentReactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentReactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6669,8 +10641,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLandmarkRequest); i {
+		file_storage_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6681,8 +10653,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLandmarkResponse); i {
+		file_storage_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6693,8 +10665,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLandmarkRequest); i {
+		file_storage_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftDeleteCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6705,8 +10677,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLandmarkResponse); i {
+		file_storage_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftDeleteCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6717,8 +10689,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeLandmarkRequest); i {
+		file_storage_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6729,8 +10701,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeLandmarkResponse); i {
+		file_storage_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6741,8 +10713,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DislikeLandmarkRequest); i {
+		file_storage_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6753,8 +10725,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DislikeLandmarkResponse); i {
+		file_storage_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6765,8 +10737,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLikesRequest); i {
+		file_storage_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFavouriteLandmarksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6777,8 +10749,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLikesResponse); i {
+		file_storage_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFavouriteLandmarksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6789,8 +10761,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewLandmarkRequest); i {
+		file_storage_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikesAmountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6801,8 +10773,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewLandmarkResponse); i {
+		file_storage_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikesAmountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6813,8 +10785,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendLandmarksRequest); i {
+		file_storage_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6825,8 +10797,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendLandmarksResponse); i {
+		file_storage_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6837,8 +10809,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRandomFeedRequest); i {
+		file_storage_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeaturesTopicsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6849,8 +10821,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRandomFeedResponse); i {
+		file_storage_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeaturedTopic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6861,8 +10833,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserRequest); i {
+		file_storage_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeaturedTopicsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6873,8 +10845,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserResponse); i {
+		file_storage_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6885,8 +10857,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentRequest); i {
+		file_storage_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6897,8 +10869,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentResponse); i {
+		file_storage_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6909,8 +10881,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCommentRequest); i {
+		file_storage_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6921,8 +10893,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCommentResponse); i {
+		file_storage_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6933,8 +10905,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditCommentRequest); i {
+		file_storage_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6945,8 +10917,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditCommentResponse); i {
+		file_storage_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6957,8 +10929,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_storage_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountFriendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6969,8 +10941,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentsRequest); i {
+		file_storage_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsFriendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6981,8 +10953,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentsResponse); i {
+		file_storage_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsFriendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6993,8 +10965,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileCommentsRequest); i {
+		file_storage_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutualFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7005,8 +10977,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileCommentsResponse); i {
+		file_storage_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutualFriendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7017,8 +10989,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFavouriteLandmarksRequest); i {
+		file_storage_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountMutualFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7029,8 +11001,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFavouriteLandmarksResponse); i {
+		file_storage_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountMutualFriendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7041,8 +11013,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLikesAmountRequest); i {
+		file_storage_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7053,8 +11025,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLikesAmountResponse); i {
+		file_storage_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreFriendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7065,8 +11037,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTagsRequest); i {
+		file_storage_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7077,8 +11049,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTagsResponse); i {
+		file_storage_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFriendRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7089,8 +11061,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeaturesTopicsRequest); i {
+		file_storage_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFriendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7101,8 +11073,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeaturedTopic); i {
+		file_storage_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7113,8 +11085,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeaturedTopicsResponse); i {
+		file_storage_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7125,8 +11097,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendRequest); i {
+		file_storage_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondFriendRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7137,8 +11109,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendResponse); i {
+		file_storage_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondFriendRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7149,8 +11121,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFriendRequest); i {
+		file_storage_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7161,8 +11133,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFriendResponse); i {
+		file_storage_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7173,8 +11145,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendsRequest); i {
+		file_storage_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockedUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7185,8 +11157,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendsResponse); i {
+		file_storage_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockedUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7197,8 +11169,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountFriendsRequest); i {
+		file_storage_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsBlockedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7209,8 +11181,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountFriendsResponse); i {
+		file_storage_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsBlockedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7221,8 +11193,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsFriendRequest); i {
+		file_storage_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestFriendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7233,8 +11205,8 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsFriendResponse); i {
+		file_storage_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendSuggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7245,7 +11217,19 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestFriendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storage_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountReviewsRequest); i {
 			case 0:
 				return &v.state
@@ -7257,7 +11241,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountReviewsResponse); i {
 			case 0:
 				return &v.state
@@ -7269,7 +11253,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateTagRequest); i {
 			case 0:
 				return &v.state
@@ -7281,7 +11265,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateTagResponse); i {
 			case 0:
 				return &v.state
@@ -7293,7 +11277,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectTagsRequest); i {
 			case 0:
 				return &v.state
@@ -7305,7 +11289,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectTagsResponse); i {
 			case 0:
 				return &v.state
@@ -7317,7 +11301,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisconnectTagsRequest); i {
 			case 0:
 				return &v.state
@@ -7329,7 +11313,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisconnectTagsResponse); i {
 			case 0:
 				return &v.state
@@ -7341,7 +11325,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteTagRequest); i {
 			case 0:
 				return &v.state
@@ -7353,7 +11337,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteTagResponse); i {
 			case 0:
 				return &v.state
@@ -7365,7 +11349,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddLandmarkTagRequest); i {
 			case 0:
 				return &v.state
@@ -7377,7 +11361,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddLandmarkTagResponse); i {
 			case 0:
 				return &v.state
@@ -7389,7 +11373,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveLandmarkTagRequest); i {
 			case 0:
 				return &v.state
@@ -7401,7 +11385,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveLandmarkTagResponse); i {
 			case 0:
 				return &v.state
@@ -7413,7 +11397,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarkTagsRequest); i {
 			case 0:
 				return &v.state
@@ -7425,7 +11409,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarkTagsResponse); i {
 			case 0:
 				return &v.state
@@ -7437,7 +11421,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConnectedTagsRequest); i {
 			case 0:
 				return &v.state
@@ -7449,7 +11433,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tag); i {
 			case 0:
 				return &v.state
@@ -7461,7 +11445,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConnectedTagsResponse); i {
 			case 0:
 				return &v.state
@@ -7473,7 +11457,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetUserTagRequest); i {
 			case 0:
 				return &v.state
@@ -7485,7 +11469,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetUserTagResponse); i {
 			case 0:
 				return &v.state
@@ -7497,7 +11481,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteUserTagRequest); i {
 			case 0:
 				return &v.state
@@ -7509,7 +11493,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteUserTagResponse); i {
 			case 0:
 				return &v.state
@@ -7521,7 +11505,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarksByTagRequest); i {
 			case 0:
 				return &v.state
@@ -7533,7 +11517,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarksByTagResponse); i {
 			case 0:
 				return &v.state
@@ -7545,7 +11529,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarksFilteredRequest); i {
 			case 0:
 				return &v.state
@@ -7557,7 +11541,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarksFilteredResponse); i {
 			case 0:
 				return &v.state
@@ -7569,7 +11553,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateLandmarkScoreRequest); i {
 			case 0:
 				return &v.state
@@ -7581,7 +11565,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateLandmarkScoreResponse); i {
 			case 0:
 				return &v.state
@@ -7593,7 +11577,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRecentFriendsFavouritesRequest); i {
 			case 0:
 				return &v.state
@@ -7605,7 +11589,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FriendLikedLandmark); i {
 			case 0:
 				return &v.state
@@ -7617,7 +11601,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRecentFriendsFavouritesResponse); i {
 			case 0:
 				return &v.state
@@ -7629,7 +11613,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsReviewedRequest); i {
 			case 0:
 				return &v.state
@@ -7641,7 +11625,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsReviewedResponse); i {
 			case 0:
 				return &v.state
@@ -7653,7 +11637,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReviewRequest); i {
 			case 0:
 				return &v.state
@@ -7665,7 +11649,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReviewResponse); i {
 			case 0:
 				return &v.state
@@ -7677,7 +11661,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLandmarkScoreRequest); i {
 			case 0:
 				return &v.state
@@ -7689,7 +11673,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLandmarkScoreResponse); i {
 			case 0:
 				return &v.state
@@ -7701,7 +11685,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMultipleViewedRequest); i {
 			case 0:
 				return &v.state
@@ -7713,7 +11697,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetMultipleViewedResponse); i {
 			case 0:
 				return &v.state
@@ -7725,7 +11709,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotInterestedRequest); i {
 			case 0:
 				return &v.state
@@ -7737,7 +11721,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotInterestedResponse); i {
 			case 0:
 				return &v.state
@@ -7749,7 +11733,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeUserTagsRequest); i {
 			case 0:
 				return &v.state
@@ -7761,7 +11745,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeUserTagsResponse); i {
 			case 0:
 				return &v.state
@@ -7773,7 +11757,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteLandmarkRequest); i {
 			case 0:
 				return &v.state
@@ -7785,7 +11769,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteLandmarkResponse); i {
 			case 0:
 				return &v.state
@@ -7797,7 +11781,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLandmarkCoordsRequest); i {
 			case 0:
 				return &v.state
@@ -7809,7 +11793,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLandmarkCoordsResponse); i {
 			case 0:
 				return &v.state
@@ -7821,7 +11805,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestGetFeedRequest); i {
 			case 0:
 				return &v.state
@@ -7833,7 +11817,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestGetFeedResponse); i {
 			case 0:
 				return &v.state
@@ -7845,7 +11829,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetNodeNameRequest); i {
 			case 0:
 				return &v.state
@@ -7857,7 +11841,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetNodeNameResponse); i {
 			case 0:
 				return &v.state
@@ -7869,7 +11853,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSimilarPlacesRequest); i {
 			case 0:
 				return &v.state
@@ -7881,7 +11865,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSimilarPlacesResponse); i {
 			case 0:
 				return &v.state
@@ -7893,7 +11877,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarkTagsWithScoreRequest); i {
 			case 0:
 				return &v.state
@@ -7905,7 +11889,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TagIdScore); i {
 			case 0:
 				return &v.state
@@ -7917,7 +11901,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLandmarkTagsWithScoreResponse); i {
 			case 0:
 				return &v.state
@@ -7929,7 +11913,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActivityRequest); i {
 			case 0:
 				return &v.state
@@ -7941,7 +11925,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LandmarkItem); i {
 			case 0:
 				return &v.state
@@ -7953,7 +11937,7 @@ func file_storage_proto_init() {
 				return nil
 			}
 		}
-		file_storage_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+		file_storage_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActivityResponse); i {
 			case 0:
 				return &v.state
@@ -7966,18 +11950,30 @@ func file_storage_proto_init() {
 			}
 		}
 	}
+	file_storage_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_storage_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_storage_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_storage_proto_msgTypes[40].OneofWrappers = []interface{}{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+		(*UploadAttachmentRequest_Sha256)(nil),
+	}
+	file_storage_proto_msgTypes[138].OneofWrappers = []interface{}{}
+	file_storage_proto_msgTypes[144].OneofWrappers = []interface{}{}
+	file_storage_proto_msgTypes[154].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_storage_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   109,
+			NumEnums:      2,
+			NumMessages:   156,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_storage_proto_goTypes,
 		DependencyIndexes: file_storage_proto_depIdxs,
+		EnumInfos:         file_storage_proto_enumTypes,
 		MessageInfos:      file_storage_proto_msgTypes,
 	}.Build()
 	File_storage_proto = out.File
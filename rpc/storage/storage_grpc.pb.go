@@ -54,12 +54,41 @@ type StorageServiceClient interface {
 	CountReviews(ctx context.Context, in *CountReviewsRequest, opts ...grpc.CallOption) (*CountReviewsResponse, error)
 	IsReviewedBy(ctx context.Context, in *IsReviewedRequest, opts ...grpc.CallOption) (*IsReviewedResponse, error)
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
+	GetCommentHistory(ctx context.Context, in *GetCommentHistoryRequest, opts ...grpc.CallOption) (*GetCommentHistoryResponse, error)
+	SoftDeleteComment(ctx context.Context, in *SoftDeleteCommentRequest, opts ...grpc.CallOption) (*SoftDeleteCommentResponse, error)
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error)
+	ReactToComment(ctx context.Context, in *ReactToCommentRequest, opts ...grpc.CallOption) (*ReactToCommentResponse, error)
+	UnreactToComment(ctx context.Context, in *UnreactToCommentRequest, opts ...grpc.CallOption) (*UnreactToCommentResponse, error)
+	GetCommentReactions(ctx context.Context, in *GetCommentReactionsRequest, opts ...grpc.CallOption) (*GetCommentReactionsResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (StorageService_UploadAttachmentClient, error)
+	GetAttachmentMeta(ctx context.Context, in *GetAttachmentMetaRequest, opts ...grpc.CallOption) (*GetAttachmentMetaResponse, error)
+	GetRatingSummary(ctx context.Context, in *GetRatingSummaryRequest, opts ...grpc.CallOption) (*GetRatingSummaryResponse, error)
+	GetRatingSummaries(ctx context.Context, in *GetRatingSummariesRequest, opts ...grpc.CallOption) (*GetRatingSummariesResponse, error)
 	// Friends
 	AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*AddFriendResponse, error)
 	DeleteFriend(ctx context.Context, in *DeleteFriendRequest, opts ...grpc.CallOption) (*DeleteFriendResponse, error)
 	GetFriends(ctx context.Context, in *GetFriendsRequest, opts ...grpc.CallOption) (*GetFriendsResponse, error)
 	CountFriends(ctx context.Context, in *CountFriendsRequest, opts ...grpc.CallOption) (*CountFriendsResponse, error)
 	IsFriend(ctx context.Context, in *IsFriendRequest, opts ...grpc.CallOption) (*IsFriendResponse, error)
+	SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error)
+	MutualFriends(ctx context.Context, in *MutualFriendsRequest, opts ...grpc.CallOption) (*MutualFriendsResponse, error)
+	CountMutualFriends(ctx context.Context, in *CountMutualFriendsRequest, opts ...grpc.CallOption) (*CountMutualFriendsResponse, error)
+	AreFriends(ctx context.Context, in *AreFriendsRequest, opts ...grpc.CallOption) (*AreFriendsResponse, error)
+	// Friend requests. Blocking removes the friendship and pending requests
+	// in both directions, blocked users are hidden from IsFriend and
+	// GetRecentFriendsFavourites and can't send requests. Requests between
+	// them fail with PERMISSION_DENIED and a google.rpc.ErrorInfo detail of
+	// reason USER_BLOCKED
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
+	GetIncomingFriendRequests(ctx context.Context, in *GetFriendRequestsRequest, opts ...grpc.CallOption) (*GetFriendRequestsResponse, error)
+	GetOutgoingFriendRequests(ctx context.Context, in *GetFriendRequestsRequest, opts ...grpc.CallOption) (*GetFriendRequestsResponse, error)
+	AcceptFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error)
+	DeclineFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error)
+	CancelFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error)
+	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error)
 	// Tags
 	AddLandmarkTag(ctx context.Context, in *AddLandmarkTagRequest, opts ...grpc.CallOption) (*AddLandmarkTagResponse, error)
 	RemoveLandmarkTag(ctx context.Context, in *RemoveLandmarkTagRequest, opts ...grpc.CallOption) (*RemoveLandmarkTagResponse, error)
@@ -348,6 +377,121 @@ func (c *storageServiceClient) GetReview(ctx context.Context, in *GetReviewReque
 	return out, nil
 }
 
+func (c *storageServiceClient) GetCommentHistory(ctx context.Context, in *GetCommentHistoryRequest, opts ...grpc.CallOption) (*GetCommentHistoryResponse, error) {
+	out := new(GetCommentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetCommentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) SoftDeleteComment(ctx context.Context, in *SoftDeleteCommentRequest, opts ...grpc.CallOption) (*SoftDeleteCommentResponse, error) {
+	out := new(SoftDeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/SoftDeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error) {
+	out := new(RestoreCommentResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/RestoreComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) ReactToComment(ctx context.Context, in *ReactToCommentRequest, opts ...grpc.CallOption) (*ReactToCommentResponse, error) {
+	out := new(ReactToCommentResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/ReactToComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UnreactToComment(ctx context.Context, in *UnreactToCommentRequest, opts ...grpc.CallOption) (*UnreactToCommentResponse, error) {
+	out := new(UnreactToCommentResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/UnreactToComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetCommentReactions(ctx context.Context, in *GetCommentReactionsRequest, opts ...grpc.CallOption) (*GetCommentReactionsResponse, error) {
+	out := new(GetCommentReactionsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetCommentReactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (StorageService_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StorageService_ServiceDesc.Streams[0], "/landmark.storage.StorageService/UploadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageServiceUploadAttachmentClient{stream}
+	return x, nil
+}
+
+type StorageService_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type storageServiceUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *storageServiceUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storageServiceUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageServiceClient) GetAttachmentMeta(ctx context.Context, in *GetAttachmentMetaRequest, opts ...grpc.CallOption) (*GetAttachmentMetaResponse, error) {
+	out := new(GetAttachmentMetaResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetAttachmentMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetRatingSummary(ctx context.Context, in *GetRatingSummaryRequest, opts ...grpc.CallOption) (*GetRatingSummaryResponse, error) {
+	out := new(GetRatingSummaryResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetRatingSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetRatingSummaries(ctx context.Context, in *GetRatingSummariesRequest, opts ...grpc.CallOption) (*GetRatingSummariesResponse, error) {
+	out := new(GetRatingSummariesResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetRatingSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) AddFriend(ctx context.Context, in *AddFriendRequest, opts ...grpc.CallOption) (*AddFriendResponse, error) {
 	out := new(AddFriendResponse)
 	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/AddFriend", in, out, opts...)
@@ -393,6 +537,132 @@ func (c *storageServiceClient) IsFriend(ctx context.Context, in *IsFriendRequest
 	return out, nil
 }
 
+func (c *storageServiceClient) SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error) {
+	out := new(SuggestFriendsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/SuggestFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) MutualFriends(ctx context.Context, in *MutualFriendsRequest, opts ...grpc.CallOption) (*MutualFriendsResponse, error) {
+	out := new(MutualFriendsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/MutualFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) CountMutualFriends(ctx context.Context, in *CountMutualFriendsRequest, opts ...grpc.CallOption) (*CountMutualFriendsResponse, error) {
+	out := new(CountMutualFriendsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/CountMutualFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AreFriends(ctx context.Context, in *AreFriendsRequest, opts ...grpc.CallOption) (*AreFriendsResponse, error) {
+	out := new(AreFriendsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/AreFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error) {
+	out := new(SendFriendRequestResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/SendFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetIncomingFriendRequests(ctx context.Context, in *GetFriendRequestsRequest, opts ...grpc.CallOption) (*GetFriendRequestsResponse, error) {
+	out := new(GetFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetIncomingFriendRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetOutgoingFriendRequests(ctx context.Context, in *GetFriendRequestsRequest, opts ...grpc.CallOption) (*GetFriendRequestsResponse, error) {
+	out := new(GetFriendRequestsResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetOutgoingFriendRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AcceptFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error) {
+	out := new(RespondFriendRequestResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/AcceptFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) DeclineFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error) {
+	out := new(RespondFriendRequestResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/DeclineFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) CancelFriendRequest(ctx context.Context, in *RespondFriendRequestRequest, opts ...grpc.CallOption) (*RespondFriendRequestResponse, error) {
+	out := new(RespondFriendRequestResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/CancelFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UnblockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error) {
+	out := new(GetBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/GetBlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error) {
+	out := new(IsBlockedResponse)
+	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/IsBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) AddLandmarkTag(ctx context.Context, in *AddLandmarkTagRequest, opts ...grpc.CallOption) (*AddLandmarkTagResponse, error) {
 	out := new(AddLandmarkTagResponse)
 	err := c.cc.Invoke(ctx, "/landmark.storage.StorageService/AddLandmarkTag", in, out, opts...)
@@ -564,12 +834,41 @@ type StorageServiceServer interface {
 	CountReviews(context.Context, *CountReviewsRequest) (*CountReviewsResponse, error)
 	IsReviewedBy(context.Context, *IsReviewedRequest) (*IsReviewedResponse, error)
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
+	GetCommentHistory(context.Context, *GetCommentHistoryRequest) (*GetCommentHistoryResponse, error)
+	SoftDeleteComment(context.Context, *SoftDeleteCommentRequest) (*SoftDeleteCommentResponse, error)
+	RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error)
+	ReactToComment(context.Context, *ReactToCommentRequest) (*ReactToCommentResponse, error)
+	UnreactToComment(context.Context, *UnreactToCommentRequest) (*UnreactToCommentResponse, error)
+	GetCommentReactions(context.Context, *GetCommentReactionsRequest) (*GetCommentReactionsResponse, error)
+	UploadAttachment(StorageService_UploadAttachmentServer) error
+	GetAttachmentMeta(context.Context, *GetAttachmentMetaRequest) (*GetAttachmentMetaResponse, error)
+	GetRatingSummary(context.Context, *GetRatingSummaryRequest) (*GetRatingSummaryResponse, error)
+	GetRatingSummaries(context.Context, *GetRatingSummariesRequest) (*GetRatingSummariesResponse, error)
 	// Friends
 	AddFriend(context.Context, *AddFriendRequest) (*AddFriendResponse, error)
 	DeleteFriend(context.Context, *DeleteFriendRequest) (*DeleteFriendResponse, error)
 	GetFriends(context.Context, *GetFriendsRequest) (*GetFriendsResponse, error)
 	CountFriends(context.Context, *CountFriendsRequest) (*CountFriendsResponse, error)
 	IsFriend(context.Context, *IsFriendRequest) (*IsFriendResponse, error)
+	SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error)
+	MutualFriends(context.Context, *MutualFriendsRequest) (*MutualFriendsResponse, error)
+	CountMutualFriends(context.Context, *CountMutualFriendsRequest) (*CountMutualFriendsResponse, error)
+	AreFriends(context.Context, *AreFriendsRequest) (*AreFriendsResponse, error)
+	// Friend requests. Blocking removes the friendship and pending requests
+	// in both directions, blocked users are hidden from IsFriend and
+	// GetRecentFriendsFavourites and can't send requests. Requests between
+	// them fail with PERMISSION_DENIED and a google.rpc.ErrorInfo detail of
+	// reason USER_BLOCKED
+	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
+	GetIncomingFriendRequests(context.Context, *GetFriendRequestsRequest) (*GetFriendRequestsResponse, error)
+	GetOutgoingFriendRequests(context.Context, *GetFriendRequestsRequest) (*GetFriendRequestsResponse, error)
+	AcceptFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error)
+	DeclineFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error)
+	CancelFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error)
+	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error)
 	// Tags
 	AddLandmarkTag(context.Context, *AddLandmarkTagRequest) (*AddLandmarkTagResponse, error)
 	RemoveLandmarkTag(context.Context, *RemoveLandmarkTagRequest) (*RemoveLandmarkTagResponse, error)
@@ -681,6 +980,36 @@ func (UnimplementedStorageServiceServer) IsReviewedBy(context.Context, *IsReview
 func (UnimplementedStorageServiceServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
 }
+func (UnimplementedStorageServiceServer) GetCommentHistory(context.Context, *GetCommentHistoryRequest) (*GetCommentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentHistory not implemented")
+}
+func (UnimplementedStorageServiceServer) SoftDeleteComment(context.Context, *SoftDeleteCommentRequest) (*SoftDeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SoftDeleteComment not implemented")
+}
+func (UnimplementedStorageServiceServer) RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedStorageServiceServer) ReactToComment(context.Context, *ReactToCommentRequest) (*ReactToCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToComment not implemented")
+}
+func (UnimplementedStorageServiceServer) UnreactToComment(context.Context, *UnreactToCommentRequest) (*UnreactToCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnreactToComment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetCommentReactions(context.Context, *GetCommentReactionsRequest) (*GetCommentReactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentReactions not implemented")
+}
+func (UnimplementedStorageServiceServer) UploadAttachment(StorageService_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAttachmentMeta(context.Context, *GetAttachmentMetaRequest) (*GetAttachmentMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentMeta not implemented")
+}
+func (UnimplementedStorageServiceServer) GetRatingSummary(context.Context, *GetRatingSummaryRequest) (*GetRatingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingSummary not implemented")
+}
+func (UnimplementedStorageServiceServer) GetRatingSummaries(context.Context, *GetRatingSummariesRequest) (*GetRatingSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingSummaries not implemented")
+}
 func (UnimplementedStorageServiceServer) AddFriend(context.Context, *AddFriendRequest) (*AddFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFriend not implemented")
 }
@@ -696,6 +1025,48 @@ func (UnimplementedStorageServiceServer) CountFriends(context.Context, *CountFri
 func (UnimplementedStorageServiceServer) IsFriend(context.Context, *IsFriendRequest) (*IsFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsFriend not implemented")
 }
+func (UnimplementedStorageServiceServer) SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFriends not implemented")
+}
+func (UnimplementedStorageServiceServer) MutualFriends(context.Context, *MutualFriendsRequest) (*MutualFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutualFriends not implemented")
+}
+func (UnimplementedStorageServiceServer) CountMutualFriends(context.Context, *CountMutualFriendsRequest) (*CountMutualFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountMutualFriends not implemented")
+}
+func (UnimplementedStorageServiceServer) AreFriends(context.Context, *AreFriendsRequest) (*AreFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AreFriends not implemented")
+}
+func (UnimplementedStorageServiceServer) SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedStorageServiceServer) GetIncomingFriendRequests(context.Context, *GetFriendRequestsRequest) (*GetFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomingFriendRequests not implemented")
+}
+func (UnimplementedStorageServiceServer) GetOutgoingFriendRequests(context.Context, *GetFriendRequestsRequest) (*GetFriendRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutgoingFriendRequests not implemented")
+}
+func (UnimplementedStorageServiceServer) AcceptFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedStorageServiceServer) DeclineFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (UnimplementedStorageServiceServer) CancelFriendRequest(context.Context, *RespondFriendRequestRequest) (*RespondFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedStorageServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedStorageServiceServer) UnblockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedStorageServiceServer) GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
+}
+func (UnimplementedStorageServiceServer) IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
 func (UnimplementedStorageServiceServer) AddLandmarkTag(context.Context, *AddLandmarkTagRequest) (*AddLandmarkTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddLandmarkTag not implemented")
 }
@@ -1276,200 +1647,640 @@ func _StorageService_GetReview_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFriendRequest)
+func _StorageService_GetCommentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddFriend(ctx, in)
+		return srv.(StorageServiceServer).GetCommentHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/AddFriend",
+		FullMethod: "/landmark.storage.StorageService/GetCommentHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddFriend(ctx, req.(*AddFriendRequest))
+		return srv.(StorageServiceServer).GetCommentHistory(ctx, req.(*GetCommentHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFriendRequest)
+func _StorageService_SoftDeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoftDeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteFriend(ctx, in)
+		return srv.(StorageServiceServer).SoftDeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/DeleteFriend",
+		FullMethod: "/landmark.storage.StorageService/SoftDeleteComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteFriend(ctx, req.(*DeleteFriendRequest))
+		return srv.(StorageServiceServer).SoftDeleteComment(ctx, req.(*SoftDeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFriendsRequest)
+func _StorageService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetFriends(ctx, in)
+		return srv.(StorageServiceServer).RestoreComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/GetFriends",
+		FullMethod: "/landmark.storage.StorageService/RestoreComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetFriends(ctx, req.(*GetFriendsRequest))
+		return srv.(StorageServiceServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_CountFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CountFriendsRequest)
+func _StorageService_ReactToComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).CountFriends(ctx, in)
+		return srv.(StorageServiceServer).ReactToComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/CountFriends",
+		FullMethod: "/landmark.storage.StorageService/ReactToComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).CountFriends(ctx, req.(*CountFriendsRequest))
+		return srv.(StorageServiceServer).ReactToComment(ctx, req.(*ReactToCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_IsFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsFriendRequest)
+func _StorageService_UnreactToComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreactToCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).IsFriend(ctx, in)
+		return srv.(StorageServiceServer).UnreactToComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/IsFriend",
+		FullMethod: "/landmark.storage.StorageService/UnreactToComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).IsFriend(ctx, req.(*IsFriendRequest))
+		return srv.(StorageServiceServer).UnreactToComment(ctx, req.(*UnreactToCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddLandmarkTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLandmarkTagRequest)
+func _StorageService_GetCommentReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentReactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddLandmarkTag(ctx, in)
+		return srv.(StorageServiceServer).GetCommentReactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/AddLandmarkTag",
+		FullMethod: "/landmark.storage.StorageService/GetCommentReactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddLandmarkTag(ctx, req.(*AddLandmarkTagRequest))
+		return srv.(StorageServiceServer).GetCommentReactions(ctx, req.(*GetCommentReactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_RemoveLandmarkTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLandmarkTagRequest)
+func _StorageService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StorageServiceServer).UploadAttachment(&storageServiceUploadAttachmentServer{stream})
+}
+
+type StorageService_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type storageServiceUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *storageServiceUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storageServiceUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StorageService_GetAttachmentMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentMetaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).RemoveLandmarkTag(ctx, in)
+		return srv.(StorageServiceServer).GetAttachmentMeta(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/RemoveLandmarkTag",
+		FullMethod: "/landmark.storage.StorageService/GetAttachmentMeta",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).RemoveLandmarkTag(ctx, req.(*RemoveLandmarkTagRequest))
+		return srv.(StorageServiceServer).GetAttachmentMeta(ctx, req.(*GetAttachmentMetaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTagRequest)
+func _StorageService_GetRatingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).CreateTag(ctx, in)
+		return srv.(StorageServiceServer).GetRatingSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/CreateTag",
+		FullMethod: "/landmark.storage.StorageService/GetRatingSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+		return srv.(StorageServiceServer).GetRatingSummary(ctx, req.(*GetRatingSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_SetUserTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserTagRequest)
+func _StorageService_GetRatingSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingSummariesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).SetUserTag(ctx, in)
+		return srv.(StorageServiceServer).GetRatingSummaries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/SetUserTag",
+		FullMethod: "/landmark.storage.StorageService/GetRatingSummaries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).SetUserTag(ctx, req.(*SetUserTagRequest))
+		return srv.(StorageServiceServer).GetRatingSummaries(ctx, req.(*GetRatingSummariesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteUserTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserTagRequest)
+func _StorageService_AddFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteUserTag(ctx, in)
+		return srv.(StorageServiceServer).AddFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/DeleteUserTag",
+		FullMethod: "/landmark.storage.StorageService/AddFriend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteUserTag(ctx, req.(*DeleteUserTagRequest))
+		return srv.(StorageServiceServer).AddFriend(ctx, req.(*AddFriendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetUserTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserTagsRequest)
+func _StorageService_DeleteFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetUserTags(ctx, in)
+		return srv.(StorageServiceServer).DeleteFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/landmark.storage.StorageService/GetUserTags",
+		FullMethod: "/landmark.storage.StorageService/DeleteFriend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetUserTags(ctx, req.(*GetUserTagsRequest))
+		return srv.(StorageServiceServer).DeleteFriend(ctx, req.(*DeleteFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/GetFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetFriends(ctx, req.(*GetFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CountFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CountFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/CountFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CountFriends(ctx, req.(*CountFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_IsFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).IsFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/IsFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).IsFriend(ctx, req.(*IsFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_SuggestFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).SuggestFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/SuggestFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).SuggestFriends(ctx, req.(*SuggestFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_MutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).MutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/MutualFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).MutualFriends(ctx, req.(*MutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CountMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CountMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/CountMutualFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CountMutualFriends(ctx, req.(*CountMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AreFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AreFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AreFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/AreFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AreFriends(ctx, req.(*AreFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/SendFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).SendFriendRequest(ctx, req.(*SendFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetIncomingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetIncomingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/GetIncomingFriendRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetIncomingFriendRequests(ctx, req.(*GetFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetOutgoingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetOutgoingFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/GetOutgoingFriendRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetOutgoingFriendRequests(ctx, req.(*GetFriendRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/AcceptFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AcceptFriendRequest(ctx, req.(*RespondFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/DeclineFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeclineFriendRequest(ctx, req.(*RespondFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/CancelFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CancelFriendRequest(ctx, req.(*RespondFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UnblockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/GetBlockedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetBlockedUsers(ctx, req.(*GetBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/IsBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).IsBlocked(ctx, req.(*IsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddLandmarkTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLandmarkTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddLandmarkTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/AddLandmarkTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddLandmarkTag(ctx, req.(*AddLandmarkTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_RemoveLandmarkTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLandmarkTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RemoveLandmarkTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/RemoveLandmarkTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RemoveLandmarkTag(ctx, req.(*RemoveLandmarkTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/CreateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_SetUserTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).SetUserTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/SetUserTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).SetUserTag(ctx, req.(*SetUserTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeleteUserTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeleteUserTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/DeleteUserTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeleteUserTag(ctx, req.(*DeleteUserTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetUserTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetUserTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/landmark.storage.StorageService/GetUserTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetUserTags(ctx, req.(*GetUserTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1759,6 +2570,42 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReview",
 			Handler:    _StorageService_GetReview_Handler,
 		},
+		{
+			MethodName: "GetCommentHistory",
+			Handler:    _StorageService_GetCommentHistory_Handler,
+		},
+		{
+			MethodName: "SoftDeleteComment",
+			Handler:    _StorageService_SoftDeleteComment_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _StorageService_RestoreComment_Handler,
+		},
+		{
+			MethodName: "ReactToComment",
+			Handler:    _StorageService_ReactToComment_Handler,
+		},
+		{
+			MethodName: "UnreactToComment",
+			Handler:    _StorageService_UnreactToComment_Handler,
+		},
+		{
+			MethodName: "GetCommentReactions",
+			Handler:    _StorageService_GetCommentReactions_Handler,
+		},
+		{
+			MethodName: "GetAttachmentMeta",
+			Handler:    _StorageService_GetAttachmentMeta_Handler,
+		},
+		{
+			MethodName: "GetRatingSummary",
+			Handler:    _StorageService_GetRatingSummary_Handler,
+		},
+		{
+			MethodName: "GetRatingSummaries",
+			Handler:    _StorageService_GetRatingSummaries_Handler,
+		},
 		{
 			MethodName: "AddFriend",
 			Handler:    _StorageService_AddFriend_Handler,
@@ -1779,6 +2626,62 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsFriend",
 			Handler:    _StorageService_IsFriend_Handler,
 		},
+		{
+			MethodName: "SuggestFriends",
+			Handler:    _StorageService_SuggestFriends_Handler,
+		},
+		{
+			MethodName: "MutualFriends",
+			Handler:    _StorageService_MutualFriends_Handler,
+		},
+		{
+			MethodName: "CountMutualFriends",
+			Handler:    _StorageService_CountMutualFriends_Handler,
+		},
+		{
+			MethodName: "AreFriends",
+			Handler:    _StorageService_AreFriends_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _StorageService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "GetIncomingFriendRequests",
+			Handler:    _StorageService_GetIncomingFriendRequests_Handler,
+		},
+		{
+			MethodName: "GetOutgoingFriendRequests",
+			Handler:    _StorageService_GetOutgoingFriendRequests_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _StorageService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _StorageService_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _StorageService_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _StorageService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _StorageService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "GetBlockedUsers",
+			Handler:    _StorageService_GetBlockedUsers_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _StorageService_IsBlocked_Handler,
+		},
 		{
 			MethodName: "AddLandmarkTag",
 			Handler:    _StorageService_AddLandmarkTag_Handler,
@@ -1840,6 +2743,12 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _StorageService_GetLandmarkTagsWithScore_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _StorageService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "storage.proto",
 }
@@ -0,0 +1,386 @@
+package lrpc
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultTagVisitBudget = 1000
+	DefaultTagConcurrency = 8
+)
+
+var (
+	// ErrVisitBudgetExceeded is returned when a traversal needs more
+	// GetConnectedTags calls than TagGraphOptions.VisitBudget allows.
+	// Results returned along with it are partial
+	ErrVisitBudgetExceeded = errors.New("tag graph visit budget exceeded")
+	ErrNoTagPath           = errors.New("no path between tags")
+)
+
+type TagGraphOptions struct {
+	// VisitBudget caps the number of GetConnectedTags calls made by a
+	// single traversal
+	VisitBudget int
+	// Concurrency caps the number of GetConnectedTags calls in flight
+	Concurrency int
+}
+
+func (o *TagGraphOptions) withDefaults() TagGraphOptions {
+	res := TagGraphOptions{
+		VisitBudget: DefaultTagVisitBudget,
+		Concurrency: DefaultTagConcurrency,
+	}
+	if o == nil {
+		return res
+	}
+	if o.VisitBudget > 0 {
+		res.VisitBudget = o.VisitBudget
+	}
+	if o.Concurrency > 0 {
+		res.Concurrency = o.Concurrency
+	}
+	return res
+}
+
+// TagNode is a tag reached from an origin tag. Score is the product of
+// edge scores along the strongest path found to it
+type TagNode struct {
+	Id    string
+	Depth int
+	Score float64
+}
+
+// tagVisitor fetches tag neighbours and counts calls against the budget
+type tagVisitor struct {
+	c      *Client
+	mu     sync.Mutex
+	visits int
+	budget int
+}
+
+// tagGraphOptions falls back to the defaults for clients that were not
+// created with New
+func (c *Client) tagGraphOptions() TagGraphOptions {
+	if c.tagGraph == (TagGraphOptions{}) {
+		return (*TagGraphOptions)(nil).withDefaults()
+	}
+	return c.tagGraph
+}
+
+func (c *Client) newTagVisitor() *tagVisitor {
+	return &tagVisitor{c: c, budget: c.tagGraphOptions().VisitBudget}
+}
+
+func (v *tagVisitor) neighbours(ctx context.Context, tagId string) ([]TagWithScore, error) {
+	v.mu.Lock()
+	if v.visits >= v.budget {
+		v.mu.Unlock()
+		return nil, ErrVisitBudgetExceeded
+	}
+	v.visits++
+	v.mu.Unlock()
+	return v.c.GetConnectedTags(ctx, tagId)
+}
+
+// expand fetches the neighbours of every tag in ids concurrently. Running
+// out of budget leaves the remaining tags without neighbours and is
+// reported once all calls are done
+func (v *tagVisitor) expand(ctx context.Context, ids []string) ([][]TagWithScore, error) {
+	res := make([][]TagWithScore, len(ids))
+	var exceeded atomic.Bool
+	err := forEachConcurrent(ctx, len(ids), v.c.tagGraphOptions().Concurrency, func(ctx context.Context, i int) error {
+		var err error
+		res[i], err = v.neighbours(ctx, ids[i])
+		if errors.Is(err, ErrVisitBudgetExceeded) {
+			exceeded.Store(true)
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if exceeded.Load() {
+		return res, ErrVisitBudgetExceeded
+	}
+	return res, nil
+}
+
+// TagNeighborhood returns the tags reachable from tagId in at most depth
+// hops over edges scored at least minScore, excluding tagId itself. The
+// graph is walked breadth first, each level fetched concurrently. Nodes
+// are sorted by score, strongest first
+func (c *Client) TagNeighborhood(ctx context.Context, tagId string, depth int, minScore float64) ([]TagNode, error) {
+	v := c.newTagVisitor()
+	nodes := map[string]*TagNode{tagId: {Id: tagId, Score: 1}}
+	frontier := []string{tagId}
+	var budgetErr error
+	for d := 1; d <= depth && len(frontier) > 0; d++ {
+		neighbours, err := v.expand(ctx, frontier)
+		if errors.Is(err, ErrVisitBudgetExceeded) {
+			budgetErr = err
+		} else if err != nil {
+			return nil, err
+		}
+		var next []string
+		for i, from := range frontier {
+			for _, edge := range neighbours[i] {
+				if edge.Score < minScore {
+					continue
+				}
+				score := nodes[from].Score * edge.Score
+				node, ok := nodes[edge.Id]
+				if !ok {
+					nodes[edge.Id] = &TagNode{Id: edge.Id, Depth: d, Score: score}
+					next = append(next, edge.Id)
+				} else if node.Depth == d && score > node.Score {
+					node.Score = score
+				}
+			}
+		}
+		if budgetErr != nil {
+			break
+		}
+		frontier = next
+	}
+	delete(nodes, tagId)
+	res := make([]TagNode, 0, len(nodes))
+	for _, node := range nodes {
+		res = append(res, *node)
+	}
+	sortTagNodes(res)
+	return res, budgetErr
+}
+
+// TagPath returns the path from one tag to another that maximises the
+// product of edge scores, along with that product. Edge scores are
+// expected to lie in [0, 1], which makes a best-first search exact
+func (c *Client) TagPath(ctx context.Context, from, to string) ([]string, float64, error) {
+	v := c.newTagVisitor()
+	best := map[string]float64{from: 1}
+	prev := map[string]string{}
+	done := map[string]bool{}
+	queue := &tagQueue{{Id: from, Score: 1}}
+	for queue.Len() > 0 {
+		cur := heap.Pop(queue).(TagNode)
+		if done[cur.Id] {
+			continue
+		}
+		done[cur.Id] = true
+		if cur.Id == to {
+			path := []string{to}
+			for id := to; id != from; {
+				id = prev[id]
+				path = append(path, id)
+			}
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path, cur.Score, nil
+		}
+		edges, err := v.neighbours(ctx, cur.Id)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, edge := range edges {
+			score := cur.Score * edge.Score
+			if s, ok := best[edge.Id]; ok && s >= score {
+				continue
+			}
+			best[edge.Id] = score
+			prev[edge.Id] = cur.Id
+			heap.Push(queue, TagNode{Id: edge.Id, Depth: cur.Depth + 1, Score: score})
+		}
+	}
+	return nil, 0, ErrNoTagPath
+}
+
+// ExpandTags returns up to k tags related to the given ones for query
+// expansion. Candidates within two hops of any input tag are ranked by the
+// sum of their path scores over all input tags; input tags are excluded
+func (c *Client) ExpandTags(ctx context.Context, tags []string, k int) ([]TagWithScore, error) {
+	const expandDepth = 2
+	v := c.newTagVisitor()
+	input := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		input[tag] = true
+	}
+	scores := map[string]float64{}
+	// every input tag is walked separately so that per-seed path scores
+	// don't leak into each other, the visitor budget is shared
+	frontiers := make([]map[string]float64, len(tags))
+	for i, tag := range tags {
+		frontiers[i] = map[string]float64{tag: 1}
+	}
+	seen := make([]map[string]bool, len(tags))
+	for i, tag := range tags {
+		seen[i] = map[string]bool{tag: true}
+	}
+	var budgetErr error
+	for d := 1; d <= expandDepth && budgetErr == nil; d++ {
+		// a tag reached from several seeds is fetched once per level
+		var ids []string
+		index := map[string]int{}
+		for _, frontier := range frontiers {
+			for id := range frontier {
+				if _, ok := index[id]; !ok {
+					index[id] = len(ids)
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) == 0 {
+			break
+		}
+		neighbours, err := v.expand(ctx, ids)
+		if errors.Is(err, ErrVisitBudgetExceeded) {
+			budgetErr = err
+		} else if err != nil {
+			return nil, err
+		}
+		next := make([]map[string]float64, len(tags))
+		for i := range next {
+			next[i] = map[string]float64{}
+		}
+		for owner, frontier := range frontiers {
+			for id, pathScore := range frontier {
+				for _, edge := range neighbours[index[id]] {
+					if seen[owner][edge.Id] {
+						continue
+					}
+					score := pathScore * edge.Score
+					if score > next[owner][edge.Id] {
+						next[owner][edge.Id] = score
+					}
+				}
+			}
+		}
+		for i, frontier := range next {
+			for id, score := range frontier {
+				seen[i][id] = true
+				if !input[id] {
+					scores[id] += score
+				}
+			}
+		}
+		frontiers = next
+	}
+	res := make([]TagWithScore, 0, len(scores))
+	for id, score := range scores {
+		res = append(res, TagWithScore{Id: id, Score: score})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].Id < res[j].Id
+	})
+	if k >= 0 && len(res) > k {
+		res = res[:k]
+	}
+	return res, budgetErr
+}
+
+// tagEdge is an undirected edge key
+type tagEdge struct {
+	id1, id2 string
+}
+
+func newTagEdge(id1, id2 string) tagEdge {
+	if id1 > id2 {
+		id1, id2 = id2, id1
+	}
+	return tagEdge{id1: id1, id2: id2}
+}
+
+type tagGraph struct {
+	tags  map[string]bool
+	edges map[tagEdge]float64
+}
+
+// tagGraphFilter limits readTagGraph to a subgraph. Depth 0 means no limit,
+// a budget above 0 replaces the configured visit budget
+type tagGraphFilter struct {
+	depth    int
+	minScore float64
+	budget   int
+}
+
+// readTagGraph walks the live graph from the given tags, tags are the
+// visited nodes and edges may lead to nodes beyond the depth limit. Tags
+// that storage reports as not found are left out
+func (c *Client) readTagGraph(ctx context.Context, roots []string, filter *tagGraphFilter) (*tagGraph, error) {
+	v := c.newTagVisitor()
+	if filter != nil && filter.budget > 0 {
+		v.budget = filter.budget
+	}
+	g := &tagGraph{tags: map[string]bool{}, edges: map[tagEdge]float64{}}
+	seen := map[string]bool{}
+	var frontier []string
+	for _, id := range roots {
+		if !seen[id] {
+			seen[id] = true
+			frontier = append(frontier, id)
+		}
+	}
+	for depth := 1; len(frontier) > 0; depth++ {
+		if filter != nil && filter.depth > 0 && depth > filter.depth {
+			break
+		}
+		var next []string
+		for _, id := range frontier {
+			edges, err := v.neighbours(ctx, id)
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			g.tags[id] = true
+			for _, edge := range edges {
+				if filter != nil && edge.Score < filter.minScore {
+					continue
+				}
+				g.edges[newTagEdge(id, edge.Id)] = edge.Score
+				if !seen[edge.Id] {
+					seen[edge.Id] = true
+					next = append(next, edge.Id)
+				}
+			}
+		}
+		frontier = next
+	}
+	return g, nil
+}
+
+func sortTagNodes(nodes []TagNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Score != nodes[j].Score {
+			return nodes[i].Score > nodes[j].Score
+		}
+		return nodes[i].Id < nodes[j].Id
+	})
+}
+
+// tagQueue is a max-heap of tag nodes by score
+type tagQueue []TagNode
+
+func (q tagQueue) Len() int           { return len(q) }
+func (q tagQueue) Less(i, j int) bool { return q[i].Score > q[j].Score }
+func (q tagQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *tagQueue) Push(x any)        { *q = append(*q, x.(TagNode)) }
+func (q *tagQueue) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
@@ -0,0 +1,104 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// a - b - c - d chain with a weaker a - d shortcut
+func newChainGraph() *fakeStorage {
+	f := newFakeStorage()
+	f.connect("a", "b", 0.5)
+	f.connect("b", "c", 0.5)
+	f.connect("c", "d", 0.5)
+	f.connect("a", "d", 0.0625)
+	f.connect("d", "e", 1)
+	return f
+}
+
+func TestTagNeighborhood(t *testing.T) {
+	client := newChainGraph().client()
+	nodes, err := client.TagNeighborhood(context.Background(), "a", 2, 0.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TagNode{
+		{Id: "b", Depth: 1, Score: 0.5},
+		{Id: "c", Depth: 2, Score: 0.25},
+	}
+	if !reflect.DeepEqual(nodes, want) {
+		t.Errorf("expected %+v, got %+v", want, nodes)
+	}
+}
+
+func TestTagPath(t *testing.T) {
+	client := newChainGraph().client()
+	path, score, err := client.TagPath(context.Background(), "a", "e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(path, want) {
+		t.Errorf("expected path %v, got %v", want, path)
+	}
+	if score != 0.125 {
+		t.Errorf("expected score 0.125, got %v", score)
+	}
+
+	_, _, err = client.TagPath(context.Background(), "a", "missing")
+	if !errors.Is(err, ErrNoTagPath) {
+		t.Errorf("expected ErrNoTagPath, got %v", err)
+	}
+}
+
+func TestExpandTags(t *testing.T) {
+	f := newChainGraph()
+	client := f.client()
+	tags, err := client.ExpandTags(context.Background(), []string{"a", "c"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// b: 0.5 from a plus 0.5 from c, d: 0.0625 from a plus 0.5 from c
+	want := []TagWithScore{{Id: "b", Score: 1}, {Id: "d", Score: 0.5625}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("expected %+v, got %+v", want, tags)
+	}
+	// a and c, then b and d once although both seeds reach them
+	if f.calls["GetConnectedTags"] != 4 {
+		t.Errorf("expected 4 calls, got %d", f.calls["GetConnectedTags"])
+	}
+}
+
+func TestExpandTagsCanceled(t *testing.T) {
+	f := newChainGraph()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := f.client().ExpandTags(ctx, []string{"a", "c"}, 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if f.calls["GetConnectedTags"] != 0 {
+		t.Errorf("expected no calls after cancellation, got %d", f.calls["GetConnectedTags"])
+	}
+}
+
+func TestTagGraphVisitBudget(t *testing.T) {
+	f := newFakeStorage()
+	for i := 0; i < 100; i++ {
+		f.connect(string(rune('A'+i)), string(rune('A'+i+1)), 1)
+	}
+	client := f.client()
+	client.tagGraph = TagGraphOptions{VisitBudget: 10, Concurrency: 2}
+
+	nodes, err := client.TagNeighborhood(context.Background(), "A", 100, 0)
+	if !errors.Is(err, ErrVisitBudgetExceeded) {
+		t.Fatalf("expected ErrVisitBudgetExceeded, got %v", err)
+	}
+	if len(nodes) == 0 {
+		t.Error("expected partial results")
+	}
+	if f.calls["GetConnectedTags"] > 10 {
+		t.Errorf("expected at most 10 calls, got %d", f.calls["GetConnectedTags"])
+	}
+}
@@ -0,0 +1,290 @@
+package lrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Taxonomy is a declarative description of the tag graph. Edges are
+// undirected
+type Taxonomy struct {
+	// Prune makes SyncTaxonomy delete tags that are connected to the
+	// declared tags but not declared themselves
+	Prune bool           `json:"prune,omitempty" yaml:"prune,omitempty"`
+	Tags  []TaxonomyTag  `json:"tags" yaml:"tags"`
+	Edges []TaxonomyEdge `json:"edges" yaml:"edges"`
+}
+
+type TaxonomyTag struct {
+	Id   string `json:"id" yaml:"id"`
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+type TaxonomyEdge struct {
+	From  string  `json:"from" yaml:"from"`
+	To    string  `json:"to" yaml:"to"`
+	Score float64 `json:"score" yaml:"score"`
+}
+
+// ReadTaxonomy reads a taxonomy in YAML or JSON format
+func ReadTaxonomy(r io.Reader) (*Taxonomy, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, so a single decoder handles both formats
+	var t Taxonomy
+	if err = yaml.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	if err = t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (t *Taxonomy) WriteYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(t); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func (t *Taxonomy) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(t)
+}
+
+// Validate checks that tags are unique and that edges only reference
+// declared tags
+func (t *Taxonomy) Validate() error {
+	tags := make(map[string]bool, len(t.Tags))
+	for _, tag := range t.Tags {
+		if tag.Id == "" {
+			return errors.New("taxonomy: tag without id")
+		}
+		if tags[tag.Id] {
+			return fmt.Errorf("taxonomy: duplicate tag %s", tag.Id)
+		}
+		tags[tag.Id] = true
+	}
+	edges := make(map[tagEdge]bool, len(t.Edges))
+	for _, edge := range t.Edges {
+		if !tags[edge.From] || !tags[edge.To] {
+			return fmt.Errorf("taxonomy: edge %s - %s references an undeclared tag", edge.From, edge.To)
+		}
+		if edge.From == edge.To {
+			return fmt.Errorf("taxonomy: tag %s is connected to itself", edge.From)
+		}
+		key := newTagEdge(edge.From, edge.To)
+		if edges[key] {
+			return fmt.Errorf("taxonomy: duplicate edge %s - %s", edge.From, edge.To)
+		}
+		edges[key] = true
+	}
+	return nil
+}
+
+type TaxonomyOpKind int
+
+const (
+	OpCreateTag TaxonomyOpKind = iota
+	OpSetTagName
+	OpConnectTags
+	OpDisconnectTags
+	OpDeleteTag
+)
+
+func (k TaxonomyOpKind) String() string {
+	switch k {
+	case OpCreateTag:
+		return "create"
+	case OpSetTagName:
+		return "name"
+	case OpConnectTags:
+		return "connect"
+	case OpDisconnectTags:
+		return "disconnect"
+	case OpDeleteTag:
+		return "delete"
+	}
+	return fmt.Sprintf("TaxonomyOpKind(%d)", int(k))
+}
+
+// TaxonomyOp is a single tag graph mutation. Id2 and Score are only set
+// for connect and disconnect, Name only for name
+type TaxonomyOp struct {
+	Kind  TaxonomyOpKind
+	Id1   string
+	Id2   string
+	Score float64
+	Name  string
+}
+
+func (op TaxonomyOp) String() string {
+	switch op.Kind {
+	case OpConnectTags:
+		return fmt.Sprintf("%s %s - %s (%g)", op.Kind, op.Id1, op.Id2, op.Score)
+	case OpDisconnectTags:
+		return fmt.Sprintf("%s %s - %s", op.Kind, op.Id1, op.Id2)
+	case OpSetTagName:
+		return fmt.Sprintf("%s %s %q", op.Kind, op.Id1, op.Name)
+	}
+	return fmt.Sprintf("%s %s", op.Kind, op.Id1)
+}
+
+// TaxonomyPlan is an ordered list of operations that brings the live
+// tag graph to the desired taxonomy
+type TaxonomyPlan []TaxonomyOp
+
+func (p TaxonomyPlan) String() string {
+	var b strings.Builder
+	for _, op := range p {
+		b.WriteString(op.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// ExportTaxonomy reads the part of the live tag graph reachable from the
+// given tags. Tag names can't be read back from storage and are left empty
+func (c *Client) ExportTaxonomy(ctx context.Context, roots []string) (*Taxonomy, error) {
+	live, err := c.readTagGraph(ctx, roots, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &Taxonomy{}
+	for id := range live.tags {
+		t.Tags = append(t.Tags, TaxonomyTag{Id: id})
+	}
+	for edge, score := range live.edges {
+		t.Edges = append(t.Edges, TaxonomyEdge{From: edge.id1, To: edge.id2, Score: shortestFloat32(score)})
+	}
+	sort.Slice(t.Tags, func(i, j int) bool { return t.Tags[i].Id < t.Tags[j].Id })
+	sort.Slice(t.Edges, func(i, j int) bool {
+		if t.Edges[i].From != t.Edges[j].From {
+			return t.Edges[i].From < t.Edges[j].From
+		}
+		return t.Edges[i].To < t.Edges[j].To
+	})
+	return t, nil
+}
+
+// SyncTaxonomy diffs the desired taxonomy against the live tag graph and
+// applies the resulting plan unless dryRun is set. The live graph is read
+// from the desired tags and their edges only, so the sync takes one
+// GetConnectedTags call per tag whatever the size of the graph. Tags
+// outside the taxonomy are only deleted if desired.Prune is set, and then
+// only those directly connected to a desired tag. Names can't be read back
+// from storage and are always reapplied. The plan is returned even if
+// applying it fails midway
+func (c *Client) SyncTaxonomy(ctx context.Context, desired *Taxonomy, dryRun bool) (TaxonomyPlan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+	roots := make([]string, len(desired.Tags))
+	for i, tag := range desired.Tags {
+		roots[i] = tag.Id
+	}
+	live, err := c.readTagGraph(ctx, roots, &tagGraphFilter{depth: 1, budget: len(roots)})
+	if err != nil {
+		return nil, err
+	}
+	plan := diffTaxonomy(live, desired)
+	if dryRun {
+		return plan, nil
+	}
+	for _, op := range plan {
+		if err = c.applyTaxonomyOp(ctx, op); err != nil {
+			return plan, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return plan, nil
+}
+
+func diffTaxonomy(live *tagGraph, desired *Taxonomy) TaxonomyPlan {
+	var plan TaxonomyPlan
+	tags := make(map[string]bool, len(desired.Tags))
+	for _, tag := range desired.Tags {
+		tags[tag.Id] = true
+		if !live.tags[tag.Id] {
+			plan = append(plan, TaxonomyOp{Kind: OpCreateTag, Id1: tag.Id})
+		}
+	}
+	for _, tag := range desired.Tags {
+		if tag.Name != "" {
+			plan = append(plan, TaxonomyOp{Kind: OpSetTagName, Id1: tag.Id, Name: tag.Name})
+		}
+	}
+	edges := make(map[tagEdge]bool, len(desired.Edges))
+	for _, edge := range desired.Edges {
+		key := newTagEdge(edge.From, edge.To)
+		edges[key] = true
+		// scores are float32 on the wire, so they are compared at that
+		// precision
+		score, ok := live.edges[key]
+		if !ok || float32(score) != float32(edge.Score) {
+			plan = append(plan, TaxonomyOp{Kind: OpConnectTags, Id1: key.id1, Id2: key.id2, Score: edge.Score})
+		}
+	}
+	var stale []TaxonomyOp
+	for key := range live.edges {
+		// edges of deleted tags go away with the tag
+		if !edges[key] && tags[key.id1] && tags[key.id2] {
+			stale = append(stale, TaxonomyOp{Kind: OpDisconnectTags, Id1: key.id1, Id2: key.id2})
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].Id1 != stale[j].Id1 {
+			return stale[i].Id1 < stale[j].Id1
+		}
+		return stale[i].Id2 < stale[j].Id2
+	})
+	plan = append(plan, stale...)
+	if !desired.Prune {
+		return plan
+	}
+	// the live graph is read one hop deep, so undeclared tags only show up
+	// as the far end of edges
+	seen := map[string]bool{}
+	var deleted []string
+	for key := range live.edges {
+		for _, id := range []string{key.id1, key.id2} {
+			if !tags[id] && !seen[id] {
+				seen[id] = true
+				deleted = append(deleted, id)
+			}
+		}
+	}
+	sort.Strings(deleted)
+	for _, id := range deleted {
+		plan = append(plan, TaxonomyOp{Kind: OpDeleteTag, Id1: id})
+	}
+	return plan
+}
+
+func (c *Client) applyTaxonomyOp(ctx context.Context, op TaxonomyOp) error {
+	switch op.Kind {
+	case OpCreateTag:
+		return c.CreateTag(ctx, op.Id1)
+	case OpSetTagName:
+		return c.SetNodeName(ctx, op.Id1, op.Name)
+	case OpConnectTags:
+		return c.ConnectTags(ctx, op.Id1, op.Id2, op.Score)
+	case OpDisconnectTags:
+		return c.DisconnectTags(ctx, op.Id1, op.Id2)
+	case OpDeleteTag:
+		return c.DeleteTag(ctx, op.Id1)
+	}
+	return fmt.Errorf("unknown taxonomy operation %d", op.Kind)
+}
@@ -0,0 +1,142 @@
+package lrpc
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testTaxonomy = `
+tags:
+  - id: museums
+    name: Museums
+  - id: art
+    name: Art
+  - id: history
+edges:
+  - from: museums
+    to: art
+    score: 0.8
+  - from: history
+    to: museums
+    score: 0.5
+`
+
+func TestReadTaxonomy(t *testing.T) {
+	tax, err := ReadTaxonomy(strings.NewReader(testTaxonomy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = tax.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	fromJSON, err := ReadTaxonomy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tax, fromJSON) {
+		t.Errorf("JSON round trip changed the taxonomy: %+v, %+v", tax, fromJSON)
+	}
+
+	_, err = ReadTaxonomy(strings.NewReader(`
+tags:
+  - id: a
+edges:
+  - from: a
+    to: b
+    score: 1
+`))
+	if err == nil {
+		t.Error("expected an error for an edge to an undeclared tag")
+	}
+}
+
+func TestSyncTaxonomy(t *testing.T) {
+	f := newFakeStorage()
+	f.connect("museums", "art", 0.8)
+	f.connect("museums", "history", 0.3)
+	f.connect("art", "history", 0.2)
+	f.connect("history", "war", 0.9)
+	f.connect("war", "battles", 0.6)
+	client := f.client()
+	// a sync reads each desired tag once, whatever the budget
+	client.tagGraph = TagGraphOptions{VisitBudget: 1, Concurrency: 1}
+
+	desired, err := ReadTaxonomy(strings.NewReader(testTaxonomy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired.Tags = append(desired.Tags, TaxonomyTag{Id: "painting"})
+	desired.Edges = append(desired.Edges, TaxonomyEdge{From: "art", To: "painting", Score: 0.7})
+
+	plan, err := client.SyncTaxonomy(context.Background(), desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TaxonomyPlan{
+		{Kind: OpCreateTag, Id1: "painting"},
+		{Kind: OpSetTagName, Id1: "museums", Name: "Museums"},
+		{Kind: OpSetTagName, Id1: "art", Name: "Art"},
+		{Kind: OpConnectTags, Id1: "history", Id2: "museums", Score: 0.5},
+		{Kind: OpConnectTags, Id1: "art", Id2: "painting", Score: 0.7},
+		{Kind: OpDisconnectTags, Id1: "art", Id2: "history"},
+	}
+	if !reflect.DeepEqual(plan, want) {
+		t.Fatalf("expected plan\n%s\ngot\n%s", want, plan)
+	}
+
+	desired.Prune = true
+	plan, err = client.SyncTaxonomy(context.Background(), desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, TaxonomyOp{Kind: OpDeleteTag, Id1: "war"})
+	if !reflect.DeepEqual(plan, want) {
+		t.Fatalf("expected pruning plan\n%s\ngot\n%s", want, plan)
+	}
+	if f.calls["CreateTag"] != 0 || f.calls["DeleteTag"] != 0 {
+		t.Fatal("dry run must not change the graph")
+	}
+
+	if _, err = client.SyncTaxonomy(context.Background(), desired, false); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = client.SyncTaxonomy(context.Background(), desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, op := range plan {
+		if op.Kind != OpSetTagName {
+			t.Errorf("expected an empty plan after sync, got %s", op)
+		}
+	}
+	if _, ok := f.tags["battles"]; !ok || f.calls["DeleteTag"] != 1 {
+		t.Error("only tags connected to the taxonomy must be pruned")
+	}
+	if f.tagNames["art"] != "Art" {
+		t.Errorf("expected tag name Art, got %q", f.tagNames["art"])
+	}
+}
+
+func TestSyncTaxonomyLargeScores(t *testing.T) {
+	f := newFakeStorage()
+	client := f.client()
+	// 1000.1 has no exact float32, so it comes back as 1000.0999755859375
+	desired := &Taxonomy{
+		Tags:  []TaxonomyTag{{Id: "a"}, {Id: "b"}},
+		Edges: []TaxonomyEdge{{From: "a", To: "b", Score: 1000.1}},
+	}
+	if _, err := client.SyncTaxonomy(context.Background(), desired, false); err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.SyncTaxonomy(context.Background(), desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 0 {
+		t.Errorf("expected an empty plan, got\n%s", plan)
+	}
+}
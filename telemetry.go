@@ -0,0 +1,172 @@
+package lrpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const instrumentationName = "github.com/emalak/lrpc"
+
+// TelemetryOptions turns on OpenTelemetry instrumentation of both
+// connections. Providers and the propagator that are not set are taken
+// from the otel globals
+type TelemetryOptions struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Propagator     propagation.TextMapPropagator
+}
+
+// telemetry starts a client span for every call, propagates it in the
+// outgoing metadata and records request count, latency and errors per
+// service and method. Spans carry the id fields of the request, e.g.
+// lrpc.userId and lrpc.landmarkId
+type telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	requests   metric.Int64Counter
+	errors     metric.Int64Counter
+	duration   metric.Float64Histogram
+}
+
+func newTelemetry(opts *TelemetryOptions) (*telemetry, error) {
+	tp, mp, prop := opts.TracerProvider, opts.MeterProvider, opts.Propagator
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	if prop == nil {
+		prop = otel.GetTextMapPropagator()
+	}
+	meter := mp.Meter(instrumentationName)
+	t := telemetry{
+		tracer:     tp.Tracer(instrumentationName),
+		propagator: prop,
+	}
+	var err error
+	t.requests, err = meter.Int64Counter("lrpc.client.requests",
+		metric.WithDescription("Calls made by the client"))
+	if err != nil {
+		return nil, err
+	}
+	t.errors, err = meter.Int64Counter("lrpc.client.errors",
+		metric.WithDescription("Calls that returned an error"))
+	if err != nil {
+		return nil, err
+	}
+	t.duration, err = meter.Float64Histogram("lrpc.client.duration",
+		metric.WithDescription("Duration of calls"), metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10))
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (t *telemetry) dialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(t.unary),
+		grpc.WithChainStreamInterceptor(t.stream),
+	}
+}
+
+func (t *telemetry) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, span, start := t.start(ctx, method, req)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	t.end(ctx, span, method, start, err)
+	return err
+}
+
+func (t *telemetry) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span, start := t.start(ctx, method, nil)
+	s, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		t.end(ctx, span, method, start, err)
+		return nil, err
+	}
+	return newFinishedStream(ctx, s, desc, func(err error) {
+		t.end(ctx, span, method, start, err)
+	}), nil
+}
+
+func (t *telemetry) start(ctx context.Context, method string, req any) (context.Context, trace.Span, time.Time) {
+	service, name := splitMethod(method)
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", name),
+	}
+	if id, ok := RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, attribute.String("lrpc.request_id", id))
+	}
+	if m, ok := req.(proto.Message); ok {
+		for _, a := range requestSummary(m.ProtoReflect()) {
+			key := "lrpc." + a.Key
+			if a.Value.Kind() == slog.KindInt64 {
+				attrs = append(attrs, attribute.Int64(key, a.Value.Int64()))
+			} else {
+				attrs = append(attrs, attribute.String(key, a.Value.String()))
+			}
+		}
+	}
+	ctx, span := t.tracer.Start(ctx, service+"/"+name,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	t.propagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span, time.Now()
+}
+
+func (t *telemetry) end(ctx context.Context, span trace.Span, method string, start time.Time, err error) {
+	service, name := splitMethod(method)
+	code := status.Code(err)
+	attrs := metric.WithAttributes(
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", name),
+	)
+	t.requests.Add(ctx, 1, attrs)
+	t.duration.Record(ctx, time.Since(start).Seconds(), attrs)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+	if code != grpccodes.OK {
+		t.errors.Add(ctx, 1, attrs, metric.WithAttributes(attribute.String("rpc.grpc.status", code.String())))
+		span.SetStatus(codes.Error, status.Convert(err).Message())
+	}
+	span.End()
+}
+
+// metadataCarrier lets a propagator write to outgoing gRPC metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
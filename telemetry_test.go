@@ -0,0 +1,102 @@
+package lrpc
+
+import (
+	"context"
+	"testing"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTelemetry(t *testing.T) {
+	spans := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	tel, err := newTelemetry(&TelemetryOptions{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		Propagator:     propagation.TraceContext{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var traceparent []string
+	fail := false
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		traceparent = md.Get("traceparent")
+		if fail {
+			return status.Error(codes.NotFound, "no such landmark")
+		}
+		return nil
+	}
+	req := &storage.GetCommentsRequest{LandmarkId: "landmark", UserId: "user"}
+	const method = "/storage.StorageService/GetComments"
+	if err := tel.unary(context.Background(), method, req, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if len(traceparent) != 1 {
+		t.Fatalf("expected the span to be propagated, got metadata %v", traceparent)
+	}
+	propagated := traceparent[0]
+	fail = true
+	if err := tel.unary(context.Background(), method, req, nil, nil, invoker); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected the invoker error, got %v", err)
+	}
+
+	ended := spans.GetSpans()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+	span := ended[0]
+	if span.Name != "storage.StorageService/GetComments" {
+		t.Errorf("unexpected span name %s", span.Name)
+	}
+	if want := "00-" + span.SpanContext.TraceID().String() + "-" + span.SpanContext.SpanID().String() + "-01"; propagated != want {
+		t.Errorf("expected %s to be propagated, got %s", want, propagated)
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, a := range span.Attributes {
+		attrs[a.Key] = a.Value
+	}
+	if attrs["lrpc.landmarkId"].AsString() != "landmark" || attrs["lrpc.userId"].AsString() != "user" {
+		t.Errorf("missing id attributes in %v", span.Attributes)
+	}
+	if ended[1].Status.Code != otelcodes.Error {
+		t.Errorf("expected the failed span to have an error status, got %v", ended[1].Status)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, p := range data.DataPoints {
+				counts[m.Name] += p.Value
+			}
+		case metricdata.Histogram[float64]:
+			for _, p := range data.DataPoints {
+				counts[m.Name] += int64(p.Count)
+				if p.Bounds[0] != 0.005 {
+					t.Errorf("expected bucket bounds in seconds, got %v", p.Bounds)
+				}
+			}
+		}
+	}
+	if counts["lrpc.client.requests"] != 2 || counts["lrpc.client.errors"] != 1 || counts["lrpc.client.duration"] != 2 {
+		t.Errorf("unexpected metrics %v", counts)
+	}
+}
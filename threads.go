@@ -0,0 +1,183 @@
+package lrpc
+
+import (
+	"context"
+	"sort"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+)
+
+// DefaultCommentsPageSize is the GetComments page size used when paging
+// through all comments of a landmark
+const DefaultCommentsPageSize = 50
+
+type CommentSort int
+
+const (
+	CommentsNewest CommentSort = iota
+	CommentsOldest
+	CommentsTopGrade
+	// CommentsMostHelpful orders by helpful reactions, then by likes
+	CommentsMostHelpful
+)
+
+// CommentThread is a comment with the replies to it. Root threads are
+// reviews, or replies whose parent could not be found
+type CommentThread struct {
+	Comment *Comment
+	Replies []*CommentThread
+}
+
+type CommentThreadOptions struct {
+	// PageSize defaults to DefaultCommentsPageSize
+	PageSize int
+	// MaxComments stops paging once that many comments were fetched, 0
+	// fetches all of them
+	MaxComments int
+	// Sort orders the root threads, replies are always oldest first
+	Sort CommentSort
+	// IncludeDeleted keeps soft deleted comments, e.g. to show a
+	// placeholder for a deleted review that has replies
+	IncludeDeleted bool
+}
+
+// GetCommentThreads pages through all comments of a landmark and assembles
+// them into threads, so replies are attached to their parent regardless
+// of the page either of them came from. Paging stops at a page without
+// new comments, so servers that ignore the offset are read once
+func (c *Client) GetCommentThreads(ctx context.Context, landmarkId, userId string, opts CommentThreadOptions) ([]*CommentThread, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultCommentsPageSize
+	}
+	var comments []*Comment
+	seen := map[string]bool{}
+	for {
+		limit := pageSize
+		if opts.MaxComments > 0 && opts.MaxComments-len(comments) < limit {
+			limit = opts.MaxComments - len(comments)
+		}
+		page, err := c.getComments(ctx, &storage.GetCommentsRequest{
+			LandmarkId:     landmarkId,
+			UserId:         userId,
+			Limit:          int32(limit),
+			Offset:         int32(len(comments)),
+			IncludeDeleted: opts.IncludeDeleted,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fresh := false
+		for _, comment := range page {
+			if !seen[comment.Id] {
+				seen[comment.Id] = true
+				fresh = true
+			}
+		}
+		if !fresh {
+			break
+		}
+		comments = append(comments, page...)
+		if len(page) < limit || (opts.MaxComments > 0 && len(comments) >= opts.MaxComments) {
+			break
+		}
+	}
+	return BuildCommentThreads(comments, opts.Sort), nil
+}
+
+// BuildCommentThreads assembles comments into threads by ReplyId. Replies
+// whose parent is missing become roots, as does one comment of every
+// reply cycle
+func BuildCommentThreads(comments []*Comment, order CommentSort) []*CommentThread {
+	threads := make(map[string]*CommentThread, len(comments))
+	for _, comment := range comments {
+		// the first copy wins if paging returned a comment twice
+		if _, ok := threads[comment.Id]; !ok {
+			threads[comment.Id] = &CommentThread{Comment: comment}
+		}
+	}
+	var roots []*CommentThread
+	attached := make(map[string]bool, len(threads))
+	for _, comment := range comments {
+		thread := threads[comment.Id]
+		if thread.Comment != comment || attached[comment.Id] {
+			continue
+		}
+		attached[comment.Id] = true
+		parent, ok := threads[comment.ReplyId]
+		if comment.ReplyId == "" || !ok || parent == thread {
+			roots = append(roots, thread)
+			continue
+		}
+		parent.Replies = append(parent.Replies, thread)
+	}
+	// comments in a reply cycle are attached but unreachable from roots
+	reachable := make(map[string]bool, len(threads))
+	var mark func(t *CommentThread)
+	mark = func(t *CommentThread) {
+		reachable[t.Comment.Id] = true
+		for _, reply := range t.Replies {
+			if !reachable[reply.Comment.Id] {
+				mark(reply)
+			}
+		}
+	}
+	for _, root := range roots {
+		mark(root)
+	}
+	for _, comment := range comments {
+		if reachable[comment.Id] {
+			continue
+		}
+		// the comment is in a cycle or replies to one, walk up the replies
+		// until a comment repeats to promote a member of the cycle
+		thread := threads[comment.Id]
+		walked := map[string]bool{}
+		for !walked[thread.Comment.Id] {
+			walked[thread.Comment.Id] = true
+			thread = threads[thread.Comment.ReplyId]
+		}
+		parent := threads[thread.Comment.ReplyId]
+		for i, reply := range parent.Replies {
+			if reply == thread {
+				parent.Replies = append(parent.Replies[:i], parent.Replies[i+1:]...)
+				break
+			}
+		}
+		roots = append(roots, thread)
+		mark(thread)
+	}
+
+	sortCommentThreads(roots, order)
+	var sortReplies func(ts []*CommentThread)
+	sortReplies = func(ts []*CommentThread) {
+		for _, t := range ts {
+			sortCommentThreads(t.Replies, CommentsOldest)
+			sortReplies(t.Replies)
+		}
+	}
+	sortReplies(roots)
+	return roots
+}
+
+func sortCommentThreads(threads []*CommentThread, order CommentSort) {
+	sort.SliceStable(threads, func(i, j int) bool {
+		a, b := threads[i].Comment, threads[j].Comment
+		switch order {
+		case CommentsOldest:
+			return a.Timestamp < b.Timestamp
+		case CommentsTopGrade:
+			if a.Grade != b.Grade {
+				return a.Grade > b.Grade
+			}
+		case CommentsMostHelpful:
+			if a.HelpfulCount != b.HelpfulCount {
+				return a.HelpfulCount > b.HelpfulCount
+			}
+			if a.LikeCount != b.LikeCount {
+				return a.LikeCount > b.LikeCount
+			}
+		}
+		return a.Timestamp > b.Timestamp
+	})
+}
@@ -0,0 +1,184 @@
+package lrpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+)
+
+// threadIds flattens threads into ids, with replies in brackets
+func threadIds(threads []*CommentThread) string {
+	s := ""
+	for i, t := range threads {
+		if i > 0 {
+			s += " "
+		}
+		s += t.Comment.Id
+		if len(t.Replies) > 0 {
+			s += "[" + threadIds(t.Replies) + "]"
+		}
+	}
+	return s
+}
+
+func TestGetCommentThreads(t *testing.T) {
+	const landmarkId = "712d6060-284b-400b-805d-cc118cd41c5d"
+	f := newFakeStorage()
+	f.comments[landmarkId] = []*storage.Comment{
+		{Id: "r1", ParentId: landmarkId, Grade: 3, Timestamp: 10, HelpfulCount: 2},
+		{Id: "r2", ParentId: landmarkId, Grade: 5, Timestamp: 20},
+		{Id: "r1.2", ParentId: landmarkId, ReplyId: "r1", Timestamp: 40},
+		// the parent of these is on the next page
+		{Id: "r3.1", ParentId: landmarkId, ReplyId: "r3", Timestamp: 50},
+		{Id: "r1.1", ParentId: landmarkId, ReplyId: "r1", Timestamp: 30},
+		{Id: "r3", ParentId: landmarkId, Grade: 4, Timestamp: 5, HelpfulCount: 2, LikeCount: 1},
+		{Id: "r1.1.1", ParentId: landmarkId, ReplyId: "r1.1", Timestamp: 60},
+		{Id: "orphan", ParentId: landmarkId, ReplyId: "deleted", Timestamp: 70},
+	}
+	client := f.client()
+
+	for _, tc := range []struct {
+		sort CommentSort
+		want string
+	}{
+		{CommentsNewest, "orphan r2 r1[r1.1[r1.1.1] r1.2] r3[r3.1]"},
+		{CommentsOldest, "r3[r3.1] r1[r1.1[r1.1.1] r1.2] r2 orphan"},
+		{CommentsTopGrade, "r2 r3[r3.1] r1[r1.1[r1.1.1] r1.2] orphan"},
+		{CommentsMostHelpful, "r3[r3.1] r1[r1.1[r1.1.1] r1.2] orphan r2"},
+	} {
+		threads, err := client.GetCommentThreads(context.Background(), landmarkId, "", CommentThreadOptions{
+			PageSize: 3,
+			Sort:     tc.sort,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := threadIds(threads); got != tc.want {
+			t.Errorf("sort %d: expected %s, got %s", tc.sort, tc.want, got)
+		}
+	}
+}
+
+func TestBuildCommentThreads_Cycle(t *testing.T) {
+	threads := BuildCommentThreads([]*Comment{
+		{Id: "a", ReplyId: "b", Timestamp: 1},
+		{Id: "b", ReplyId: "a", Timestamp: 2},
+		{Id: "c", Timestamp: 3},
+	}, CommentsNewest)
+	if got, want := threadIds(threads), "c a[b]"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestBuildCommentThreads_CycleWithTail(t *testing.T) {
+	// t replies to the cycle and comes first, so it is met before a and b
+	threads := BuildCommentThreads([]*Comment{
+		{Id: "t", ReplyId: "a", Timestamp: 3},
+		{Id: "a", ReplyId: "b", Timestamp: 1},
+		{Id: "b", ReplyId: "a", Timestamp: 2},
+		{Id: "c", Timestamp: 4},
+	}, CommentsNewest)
+	if got, want := threadIds(threads), "c a[b t]"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestGetCommentThreads_Deleted(t *testing.T) {
+	const landmarkId = "712d6060-284b-400b-805d-cc118cd41c5d"
+	f := newFakeStorage()
+	f.comments[landmarkId] = []*storage.Comment{
+		{Id: "r1", ParentId: landmarkId, Timestamp: 10, Deleted: true},
+		{Id: "r1.1", ParentId: landmarkId, ReplyId: "r1", Timestamp: 20},
+		{Id: "r2", ParentId: landmarkId, Timestamp: 30},
+	}
+	client := f.client()
+
+	threads, err := client.GetCommentThreads(context.Background(), landmarkId, "", CommentThreadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := threadIds(threads), "r2 r1.1"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	threads, err = client.GetCommentThreads(context.Background(), landmarkId, "", CommentThreadOptions{IncludeDeleted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := threadIds(threads), "r2 r1[r1.1]"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if !threads[1].Comment.Deleted {
+		t.Error("expected r1 to be marked deleted")
+	}
+}
+
+func TestGetCommentThreads_IgnoredOffset(t *testing.T) {
+	const landmarkId = "712d6060-284b-400b-805d-cc118cd41c5d"
+	f := newFakeStorage()
+	f.ignoreOffset = true
+	f.comments[landmarkId] = []*storage.Comment{
+		{Id: "r1", ParentId: landmarkId, Timestamp: 10},
+		{Id: "r2", ParentId: landmarkId, Timestamp: 20},
+		{Id: "r3", ParentId: landmarkId, Timestamp: 30},
+	}
+	client := f.client()
+
+	threads, err := client.GetCommentThreads(context.Background(), landmarkId, "", CommentThreadOptions{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := threadIds(threads), "r2 r1"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if f.calls["GetComments"] != 2 {
+		t.Errorf("expected paging to stop after a page without new comments, got %d calls", f.calls["GetComments"])
+	}
+}
+
+func TestCommentReactions(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeStorage()
+	client := f.client()
+
+	reactions, err := client.GetCommentReactions(ctx, "comment", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reactions != nil {
+		t.Errorf("expected no reactions, got %+v", reactions)
+	}
+
+	for _, r := range []struct {
+		user string
+		kind ReactionKind
+	}{{"alice", ReactionHelpful}, {"bob", ReactionLike}, {"carol", ReactionLike}, {"alice", ReactionLike}} {
+		if err = client.ReactToComment(ctx, r.user, "comment", r.kind); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = client.UnreactToComment(ctx, "bob", "comment"); err != nil {
+		t.Fatal(err)
+	}
+
+	reactions, err = client.GetCommentReactions(ctx, "comment", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CommentReaction{
+		{UserId: "alice", Kind: ReactionLike, Timestamp: 4},
+		{UserId: "carol", Kind: ReactionLike, Timestamp: 3},
+	}
+	if !reflect.DeepEqual(reactions, want) {
+		t.Errorf("expected %+v, got %+v", want, reactions)
+	}
+	reactions, err = client.GetCommentReactions(ctx, "comment", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reactions, want[1:]) {
+		t.Errorf("expected the second page %+v, got %+v", want[1:], reactions)
+	}
+}
@@ -0,0 +1,88 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// UpdateUserTagsAttempts is how many times UpdateUserTags re-reads and
+// reapplies its diff when the tags change underneath it
+const UpdateUserTagsAttempts = 3
+
+var ErrUserTagsConflict = errors.New("user tags changed concurrently")
+
+// UserTagsConflictError is returned when a conditional write of a user's
+// tags finds them at a version other than the expected one
+type UserTagsConflictError struct {
+	UserId  string
+	Version int64
+}
+
+func (e *UserTagsConflictError) Error() string {
+	return fmt.Sprintf("user %s: tags are no longer at version %d", e.UserId, e.Version)
+}
+
+func (e *UserTagsConflictError) Is(target error) bool {
+	return target == ErrUserTagsConflict
+}
+
+// UpdateUserTags adds and removes tags from the user's interests without
+// clobbering concurrent edits. The write is conditional on the version
+// that was read, and the diff is reapplied to fresh tags on conflict up to
+// UpdateUserTagsAttempts times. Servers without versioning report version
+// 0 and ignore the condition. Returns the resulting tags
+func (c *Client) UpdateUserTags(ctx context.Context, userId string, add, remove []string) ([]string, error) {
+	var err error
+	for attempt := 0; attempt < UpdateUserTagsAttempts; attempt++ {
+		var (
+			tags    []string
+			version int64
+		)
+		tags, version, err = c.GetUserTagsVersion(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+		updated, changed := applyTagsDiff(tags, add, remove)
+		if !changed {
+			return tags, nil
+		}
+		_, err = c.ChangeUserTagsIfVersion(ctx, userId, updated, version)
+		if err == nil {
+			return updated, nil
+		}
+		if !errors.Is(err, ErrUserTagsConflict) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// applyTagsDiff returns tags with add appended and remove taken out,
+// keeping the original order. Removal wins over addition
+func applyTagsDiff(tags, add, remove []string) ([]string, bool) {
+	removed := make(map[string]bool, len(remove))
+	for _, tag := range remove {
+		removed[tag] = true
+	}
+	present := make(map[string]bool, len(tags)+len(add))
+	res := make([]string, 0, len(tags)+len(add))
+	changed := false
+	for _, tag := range tags {
+		if removed[tag] || present[tag] {
+			changed = changed || removed[tag]
+			continue
+		}
+		present[tag] = true
+		res = append(res, tag)
+	}
+	for _, tag := range add {
+		if removed[tag] || present[tag] {
+			continue
+		}
+		present[tag] = true
+		res = append(res, tag)
+		changed = true
+	}
+	return res, changed
+}
@@ -0,0 +1,56 @@
+package lrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateUserTags(t *testing.T) {
+	const userId = "d00ba81c-4fd9-4e01-a383-97e25f926020"
+	f := newFakeStorage()
+	f.userTags[userId] = []string{"art", "history"}
+	client := f.client()
+
+	// the other device adds a tag between our read and write once
+	concurrent := true
+	f.onChangeUserTags = func() {
+		if concurrent {
+			concurrent = false
+			f.userTags[userId] = append(f.userTags[userId], "music")
+			f.userTagsVersion[userId]++
+		}
+	}
+	tags, err := client.UpdateUserTags(context.Background(), userId, []string{"food"}, []string{"history"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"art", "music", "food"}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("expected %v, got %v", want, tags)
+	}
+	if !reflect.DeepEqual(f.userTags[userId], want) {
+		t.Errorf("expected stored %v, got %v", want, f.userTags[userId])
+	}
+	if f.calls["ChangeUserTags"] != 2 {
+		t.Errorf("expected 2 writes, got %d", f.calls["ChangeUserTags"])
+	}
+}
+
+func TestUpdateUserTags_Conflict(t *testing.T) {
+	const userId = "d00ba81c-4fd9-4e01-a383-97e25f926020"
+	f := newFakeStorage()
+	client := f.client()
+	f.onChangeUserTags = func() {
+		f.userTagsVersion[userId]++
+	}
+	_, err := client.UpdateUserTags(context.Background(), userId, []string{"food"}, nil)
+	var conflict *UserTagsConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrUserTagsConflict) {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+	if f.calls["ChangeUserTags"] != UpdateUserTagsAttempts {
+		t.Errorf("expected %d writes, got %d", UpdateUserTagsAttempts, f.calls["ChangeUserTags"])
+	}
+}
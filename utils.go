@@ -1,6 +1,18 @@
 package lrpc
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+
+	storage "github.com/emalak/lrpc/rpc/storage"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
 
 func uuidArray(in []string) ([]uuid.UUID, error) {
 	array := make([]uuid.UUID, len(in))
@@ -18,3 +30,125 @@ type Coordinates struct {
 	Longitude float64
 	Latitude  float64
 }
+
+// storageCoordinates fills both the legacy float and the double fields so
+// that servers which predate the double fields keep working
+func storageCoordinates(c Coordinates) *storage.Coordinates {
+	return &storage.Coordinates{
+		Longitude:   float32(c.Longitude),
+		Latitude:    float32(c.Latitude),
+		Longitude64: proto.Float64(c.Longitude),
+		Latitude64:  proto.Float64(c.Latitude),
+	}
+}
+
+// preciseOr returns the double field if the server has set it and falls
+// back to the legacy float field otherwise
+func preciseOr(precise *float64, legacy float32) float64 {
+	if precise != nil {
+		return *precise
+	}
+	return float64(legacy)
+}
+
+// shortestFloat32 turns a float32 that was widened to float64 back into the
+// shortest decimal, so that 0.1f reads as 0.1 and not 0.10000000149011612
+func shortestFloat32(f float64) float64 {
+	res, err := strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+	if err != nil {
+		return f
+	}
+	return res
+}
+
+// forEachConcurrent calls fn for every index in [0, n) with at most limit
+// calls in flight. The first error cancels the context passed to the
+// remaining calls and is returned
+func forEachConcurrent(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, limit)
+	)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	if firstErr == nil {
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+// finishedStream calls onFinish once when the stream ends, with nil if it
+// ended successfully. A stream ends when a message or its status is
+// received, when sending fails, or when ctx, the context the stream was
+// opened with, is done, so that streams dropped midway are finished too.
+// Requests of a stream are not inspected as a stream carries many of them
+type finishedStream struct {
+	grpc.ClientStream
+	desc     *grpc.StreamDesc
+	onFinish func(err error)
+	once     sync.Once
+	done     chan struct{}
+}
+
+func newFinishedStream(ctx context.Context, s grpc.ClientStream, desc *grpc.StreamDesc, onFinish func(err error)) *finishedStream {
+	f := &finishedStream{ClientStream: s, desc: desc, onFinish: onFinish, done: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+			f.finish(status.FromContextError(ctx.Err()).Err())
+		case <-f.done:
+		}
+	}()
+	return f
+}
+
+func (s *finishedStream) finish(err error) {
+	s.once.Do(func() {
+		close(s.done)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		s.onFinish(err)
+	})
+}
+
+// SendMsg finishes the stream on errors other than io.EOF, which only
+// tells that the status is waiting to be received
+func (s *finishedStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && !errors.Is(err, io.EOF) {
+		s.finish(err)
+	}
+	return err
+}
+
+func (s *finishedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil || !s.desc.ServerStreams {
+		s.finish(err)
+	}
+	return err
+}
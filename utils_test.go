@@ -0,0 +1,20 @@
+package lrpc
+
+import (
+	"testing"
+)
+
+func TestCoordinatesPrecision(t *testing.T) {
+	c := Coordinates{Longitude: 37.6184237, Latitude: 55.7512447}
+	pc := storageCoordinates(c)
+	if got := preciseOr(pc.Longitude64, pc.Longitude); got != c.Longitude {
+		t.Errorf("expected longitude %v, got %v", c.Longitude, got)
+	}
+	if got := preciseOr(pc.Latitude64, pc.Latitude); got != c.Latitude {
+		t.Errorf("expected latitude %v, got %v", c.Latitude, got)
+	}
+	// Old servers only fill the float fields
+	if got := preciseOr(nil, pc.Latitude); got != float64(float32(c.Latitude)) {
+		t.Errorf("expected fallback latitude %v, got %v", float32(c.Latitude), got)
+	}
+}